@@ -0,0 +1,283 @@
+package gotik
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+
+	"github.com/jjcinaz/gotik/rsc"
+)
+
+// FindingKind is the kind of problem reported by AnalyzeFilterRules.
+type FindingKind int
+
+const (
+	FindingShadowed             FindingKind = iota // the rule can never match: an earlier rule takes all of its traffic
+	FindingDuplicate                               // the rule is the same as an earlier rule
+	FindingMissingChain                            // the rule jumps to a chain which has no rules
+	FindingUnreferencedChain                       // no rule jumps to the chain
+	FindingUndefinedAddressList                    // the rule matches an address list which is not defined
+)
+
+func (k FindingKind) String() string {
+	switch k {
+	case FindingShadowed:
+		return "shadowed"
+	case FindingDuplicate:
+		return "duplicate"
+	case FindingMissingChain:
+		return "missing chain"
+	case FindingUnreferencedChain:
+		return "unreferenced chain"
+	case FindingUndefinedAddressList:
+		return "undefined address list"
+	}
+	return fmt.Sprintf("FindingKind(%d)", int(k))
+}
+
+// RuleFinding is one problem found by AnalyzeFilterRules.
+type RuleFinding struct {
+	Kind  FindingKind
+	Rule  int    // index of the rule in the analyzed slice, -1 for FindingUnreferencedChain
+	By    int    // for FindingShadowed and FindingDuplicate, the index of the earlier rule; otherwise -1
+	Chain string // chain of the rule, or the unreferenced chain
+	Name  string // the missing chain or the undefined address list
+}
+
+func (f *RuleFinding) String() string {
+	switch f.Kind {
+	case FindingShadowed, FindingDuplicate:
+		return fmt.Sprintf("%s: rule %d in chain %s, by rule %d", f.Kind, f.Rule, f.Chain, f.By)
+	case FindingUnreferencedChain:
+		return fmt.Sprintf("%s: %s", f.Kind, f.Chain)
+	}
+	return fmt.Sprintf("%s: rule %d in chain %s: %s", f.Kind, f.Rule, f.Chain, f.Name)
+}
+
+// builtinChains are the chains of the IPv4 filter which the router itself sends packets through.
+var builtinChains = map[string]bool{"input": true, "forward": true, "output": true}
+
+// terminalActions are the actions after which a packet does not go on to the next rule of the chain.
+var terminalActions = map[string]bool{"accept": true, "drop": true, "reject": true, "tarpit": true, "return": true}
+
+// nonMatchers are the tik tags of IPv4FilterRule which are not packet matchers.
+var nonMatchers = map[string]bool{
+	".id": true, "action": true, "address-list": true, "address-list-timeout": true, "chain": true,
+	"comment": true, "disabled": true, "dynamic": true, "invalid": true, "jump-target": true,
+	"log": true, "log-prefix": true, "place-before": true, "reject-with": true,
+}
+
+// partialMatchers are matchers which take only a share of the packets they otherwise match, so a
+// rule using one of them never shadows another rule.
+var partialMatchers = map[string]bool{
+	"limit": true, "dst-limit": true, "nth": true, "random": true, "connection-limit": true,
+	"connection-rate": true, "psd": true,
+}
+
+// AnalyzeFilterRules checks a list of IPv4 filter rules, in the order the router holds them,
+// without talking to the router.  It reports:
+//
+//   - rules which can never match because an earlier enabled rule in the same chain with an
+//     accept, drop, reject, tarpit or return action matches all of their traffic;
+//   - rules which are the same as an earlier rule in the chain, apart from the comment;
+//   - jumps to chains which have no rules;
+//   - chains, other than input, forward and output, which no rule jumps to;
+//   - src-address-list and dst-address-list values naming a list which is neither in lists
+//     nor filled by an add-src-to-address-list or add-dst-to-address-list rule.  The check is
+//     skipped if lists is nil.
+//
+// Disabled rules are not analyzed.  Findings are returned in rule order, followed by the
+// unreferenced chains.
+func AnalyzeFilterRules(rules []IPv4FilterRule, lists []AddressList) []RuleFinding {
+	findings := make([]RuleFinding, 0)
+	chains := make(map[string]bool)
+	jumped := make(map[string]bool)
+	defined := make(map[string]bool)
+	for _, r := range rules {
+		chains[r.Chain] = true
+		if r.Disabled {
+			continue
+		}
+		switch r.Action {
+		case "jump":
+			jumped[r.JumpTarget] = true
+		case "add-src-to-address-list", "add-dst-to-address-list":
+			defined[r.AddressList] = true
+		}
+	}
+	for _, l := range lists {
+		defined[l.List] = true
+	}
+
+	for j := range rules {
+		r := &rules[j]
+		if r.Disabled {
+			continue
+		}
+		for i := 0; i < j; i++ {
+			earlier := &rules[i]
+			if earlier.Disabled || earlier.Chain != r.Chain {
+				continue
+			}
+			if RuleCompareEq(*earlier, *r, []string{"Comment", "Dynamic", "Invalid", "Disabled", "PlaceBefore"}) {
+				findings = append(findings, RuleFinding{Kind: FindingDuplicate, Rule: j, By: i, Chain: r.Chain})
+				break
+			}
+			if terminalActions[earlier.Action] && ruleCovers(earlier, r) {
+				findings = append(findings, RuleFinding{Kind: FindingShadowed, Rule: j, By: i, Chain: r.Chain})
+				break
+			}
+		}
+		if r.Action == "jump" && !chains[r.JumpTarget] {
+			findings = append(findings, RuleFinding{Kind: FindingMissingChain, Rule: j, By: -1, Chain: r.Chain, Name: r.JumpTarget})
+		}
+		if lists != nil {
+			for _, name := range []string{r.SrcAddressList, r.DstAddressList} {
+				name = strings.TrimPrefix(name, "!")
+				if len(name) > 0 && !defined[name] {
+					findings = append(findings, RuleFinding{Kind: FindingUndefinedAddressList, Rule: j, By: -1, Chain: r.Chain, Name: name})
+				}
+			}
+		}
+	}
+
+	for _, r := range rules {
+		if !builtinChains[r.Chain] && !jumped[r.Chain] {
+			findings = append(findings, RuleFinding{Kind: FindingUnreferencedChain, Rule: -1, By: -1, Chain: r.Chain})
+			jumped[r.Chain] = true // report each chain once
+		}
+	}
+	return findings
+}
+
+// ruleCovers returns true if every packet matched by b is also matched by a.  It errs on the
+// side of false: matchers it cannot compare must be equal.
+func ruleCovers(a, b *IPv4FilterRule) bool {
+	va := reflect.ValueOf(a).Elem()
+	vb := reflect.ValueOf(b).Elem()
+	t := va.Type()
+	for i := 0; i < va.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("tik")
+		if len(tag) == 0 || nonMatchers[tag] {
+			continue
+		}
+		if ap, ok := va.Field(i).Interface().(PortSet); ok {
+			if !ap.Covers(vb.Field(i).Interface().(PortSet)) {
+				return false
+			}
+			continue
+		}
+		if t.Field(i).Type.Kind() != reflect.String {
+			continue
+		}
+		av := va.Field(i).String()
+		if len(av) == 0 {
+			continue
+		}
+		if partialMatchers[tag] {
+			return false
+		}
+		bv := vb.Field(i).String()
+		if av == bv {
+			continue
+		}
+		if len(bv) == 0 || strings.HasPrefix(av, "!") || strings.HasPrefix(bv, "!") {
+			return false
+		}
+		switch tag {
+		case "src-address", "dst-address":
+			if !ipv4SpanContains(av, bv) {
+				return false
+			}
+		case "connection-state", "connection-nat-state", "connection-type":
+			if !setContains(av, bv) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// ipv4Span parses an IPv4 address, CIDR network or range such as 10.0.0.1-10.0.0.9 into the
+// first and last address it covers.
+func ipv4Span(s string) (lo, hi uint32, ok bool) {
+	if from, to, found := strings.Cut(s, "-"); found {
+		f, t := net.ParseIP(from).To4(), net.ParseIP(to).To4()
+		if f == nil || t == nil {
+			return 0, 0, false
+		}
+		return binary.BigEndian.Uint32(f), binary.BigEndian.Uint32(t), true
+	}
+	n, err := parseNet(s)
+	if err != nil {
+		return 0, 0, false
+	}
+	ip := n.IP.To4()
+	if ip == nil || len(n.Mask) != net.IPv4len {
+		return 0, 0, false
+	}
+	lo = binary.BigEndian.Uint32(ip)
+	return lo, lo | ^binary.BigEndian.Uint32(n.Mask), true
+}
+
+// ipv4SpanContains returns true if the addresses of b are all within a.
+func ipv4SpanContains(a, b string) bool {
+	alo, ahi, ok := ipv4Span(a)
+	if !ok {
+		return false
+	}
+	blo, bhi, ok := ipv4Span(b)
+	return ok && alo <= blo && bhi <= ahi
+}
+
+// setContains returns true if every item of the comma separated list b is in a.
+func setContains(a, b string) bool {
+	have := make(map[string]bool)
+	for _, x := range strings.Split(a, ",") {
+		have[x] = true
+	}
+	for _, x := range strings.Split(b, ",") {
+		if !have[x] {
+			return false
+		}
+	}
+	return true
+}
+
+// FilterRulesFromExport returns the IPv4 filter rules added by a parsed export, in order, for
+// use with AnalyzeFilterRules.
+func FilterRulesFromExport(cfg *rsc.Config) []IPv4FilterRule {
+	items := cfg.Items("/ip/firewall/filter")
+	rules := make([]IPv4FilterRule, 0, len(items))
+	for _, item := range items {
+		if item.Command != "add" {
+			continue
+		}
+		var rule IPv4FilterRule
+		parseTikObject(item.Map(), &rule)
+		if len(rule.Action) == 0 {
+			rule.Action = "accept"
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// AddressListsFromExport returns the IPv4 address list entries added by a parsed export.
+func AddressListsFromExport(cfg *rsc.Config) []AddressList {
+	items := cfg.Items("/ip/firewall/address-list")
+	lists := make([]AddressList, 0, len(items))
+	for _, item := range items {
+		if item.Command != "add" {
+			continue
+		}
+		var entry AddressList
+		parseTikObject(item.Map(), &entry)
+		lists = append(lists, entry)
+	}
+	return lists
+}
@@ -0,0 +1,53 @@
+package gotik_test
+
+import (
+	"testing"
+
+	"github.com/jjcinaz/gotik"
+	"github.com/jjcinaz/gotik/rsc"
+)
+
+const analyzeExport = `/ip firewall address-list
+add address=192.0.2.10 list=admins
+/ip firewall filter
+add action=accept chain=input connection-state=established,related
+add action=accept chain=input connection-state=established
+add action=drop chain=input src-address=10.0.0.0/8
+add action=drop chain=input protocol=tcp src-address=10.1.0.0/16 dst-port=22
+add action=accept chain=input src-address-list=admins
+add action=accept chain=input src-address-list=admins comment=again
+add action=accept chain=input src-address-list=trusted
+add action=jump chain=forward jump-target=lan
+add action=jump chain=forward jump-target=wan
+add action=accept chain=lan
+add action=drop chain=orphan
+add action=log chain=input src-address=10.2.0.0/16 disabled=yes
+`
+
+func TestAnalyzeFilterRules(t *testing.T) {
+	cfg, err := rsc.ParseString(analyzeExport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rules := gotik.FilterRulesFromExport(cfg)
+	if len(rules) != 12 {
+		t.Fatalf("got %d rules; want 12", len(rules))
+	}
+	findings := gotik.AnalyzeFilterRules(rules, gotik.AddressListsFromExport(cfg))
+	want := []gotik.RuleFinding{
+		{Kind: gotik.FindingShadowed, Rule: 1, By: 0, Chain: "input"},
+		{Kind: gotik.FindingShadowed, Rule: 3, By: 2, Chain: "input"},
+		{Kind: gotik.FindingDuplicate, Rule: 5, By: 4, Chain: "input"},
+		{Kind: gotik.FindingUndefinedAddressList, Rule: 6, By: -1, Chain: "input", Name: "trusted"},
+		{Kind: gotik.FindingMissingChain, Rule: 8, By: -1, Chain: "forward", Name: "wan"},
+		{Kind: gotik.FindingUnreferencedChain, Rule: -1, By: -1, Chain: "orphan"},
+	}
+	if len(findings) != len(want) {
+		t.Fatalf("got findings %v; want %v", findings, want)
+	}
+	for i := range want {
+		if findings[i] != want[i] {
+			t.Errorf("finding %d: got %s; want %s", i, findings[i].String(), want[i].String())
+		}
+	}
+}
@@ -0,0 +1,273 @@
+package gotik
+
+//go:generate go run ./internal/mockgen -in api.go -out gotikmock/gotikmock.go -pkg gotikmock
+
+import (
+	"context"
+	"io"
+	"net"
+	"time"
+)
+
+// The interfaces below describe the methods of *Client grouped by area so that consumers can
+// depend on just the parts they use and substitute a fake in unit tests.  RouterOS combines
+// all of them.  A generated mock implementation is available in the gotikmock package.
+// When adding a method to Client, add it to the matching interface and run go generate.
+
+// CommandAPI covers the connection and raw command methods.
+type CommandAPI interface {
+	AllowInsecureCleartext(value bool)
+	Login(username, password string) error
+	Close()
+	Shutdown(ctx context.Context) error
+	CurrentAddress() string
+	CurrentVersion() (string, int, int, int)
+	Async() <-chan error
+	Run(sentence ...string) (*Reply, error)
+	RunArgs(sentence []string) (*Reply, error)
+	RunCmd(cmd string, sentence ...string) (*Reply, error)
+	RunAsync(sentence ...string) *Pending
+	RunArgsAsync(sentence []string) *Pending
+	Batch() *Batch
+	Listen(sentence ...string) (*ListenReply, error)
+	ListenArgs(sentence []string) (*ListenReply, error)
+	ListenArgsQueue(sentence []string, queueSize int) (*ListenReply, error)
+	SetDryRun(enabled bool)
+	DryRun() bool
+	ChangePlan() []PlannedChange
+	ResetChangePlan()
+	SetReadOnly(enabled bool)
+	ReadOnly() bool
+	SetJournal(j *Journal) error
+	BeginTx() (*Tx, error)
+	WithTx(fn func() error) error
+	CommitConfirm(timeout time.Duration, changes func() error) error
+}
+
+// FirewallAPI covers firewall rules and address lists.
+type FirewallAPI interface {
+	GetIPv4Filters(chain string) ([]IPv4FilterRule, error)
+	RemoveIPv4FilterRule(id string) error
+	EnableIPv4FilterRule(id string) error
+	DisableIPv4FilterRule(id string) error
+	ResetFilterCounters(chain string) error
+	ResetCounters(id string) error
+	SampleFilterRates(ctx context.Context, chain string, interval time.Duration) (<-chan FilterSample, error)
+	ApplyFilterChain(chain string, desired []IPv4FilterRule) ([]ChainChange, error)
+	GetIPv4Nat(chain string) ([]IPv4NatRule, error)
+	RemoveIPv4NatRule(id string) error
+	AddPortForward(inInterface, protocol string, dstPort PortSet, toAddress string, toPorts PortSet, comment string) error
+	AddMasquerade(outInterface, comment string) error
+	AddSrcNAT(srcAddress, outInterface, toAddress, comment string) error
+	GetIPv4Mangle(chain string) ([]IPv4MangleRule, error)
+	AddIPv4MangleRule(rule IPv4MangleRule) (string, error)
+	RemoveIPv4MangleRule(id string) error
+	EnableIPv4MangleRule(id string) error
+	DisableIPv4MangleRule(id string) error
+	GetIPv4Raw(chain string) ([]IPv4RawRule, error)
+	AddIPv4RawRule(rule IPv4RawRule) (string, error)
+	RemoveIPv4RawRule(id string) error
+	EnableIPv4RawRule(id string) error
+	DisableIPv4RawRule(id string) error
+	GetIPv6Filters(chain string) ([]IPv6FilterRule, error)
+	AddIPv6FilterRule(rule IPv6FilterRule) (string, error)
+	RemoveIPv6FilterRule(id string) error
+	EnableIPv6FilterRule(id string) error
+	DisableIPv6FilterRule(id string) error
+	GetIPv6Nat(chain string) ([]IPv6NatRule, error)
+	AddIPv6NatRule(rule IPv6NatRule) (string, error)
+	RemoveIPv6NatRule(id string) error
+	EnableIPv6NatRule(id string) error
+	DisableIPv6NatRule(id string) error
+	GetIPv6Mangle(chain string) ([]IPv6MangleRule, error)
+	AddIPv6MangleRule(rule IPv6MangleRule) (string, error)
+	RemoveIPv6MangleRule(id string) error
+	EnableIPv6MangleRule(id string) error
+	DisableIPv6MangleRule(id string) error
+	GetIPv6Raw(chain string) ([]IPv6RawRule, error)
+	AddIPv6RawRule(rule IPv6RawRule) (string, error)
+	RemoveIPv6RawRule(id string) error
+	EnableIPv6RawRule(id string) error
+	DisableIPv6RawRule(id string) error
+	GetIDS(in interface{}) ([]string, string, error)
+	CommitRule(in interface{}) error
+	AddRule(in interface{}) error
+	RemoveRule(in interface{}) error
+	RemoveRuleByID(in interface{}) error
+	ModifyRule(in interface{}, action string) error
+	MoveRule(in interface{}, before string) error
+	RuleIsDisabled(in interface{}) (bool, error)
+	AddObject(in interface{}) error
+	GetIPv4AddressList(listname string) ([]AddressList, error)
+	GetIPv6AddressList(listname string) ([]AddressList, error)
+	AuditIPv4AddressList(listname string, list []AddressList, goodList map[string]string, applyAudits bool) ([]AddressListAudit, error)
+	AuditIPv6AddressList(listname string, list []AddressList, goodList map[string]string, applyAudits bool) ([]AddressListAudit, error)
+	GetConnections(f ConnectionFilter) ([]Connection, error)
+	ListenConnections(f ConnectionFilter, fn func(Connection) error) error
+	RemoveConnections(f ConnectionFilter) (int, error)
+	GetConnectionTracking() (ConnectionTracking, error)
+	SetConnectionTracking(settings ConnectionTracking) error
+}
+
+// InterfaceAPI covers interfaces, ARP and neighbor discovery.
+type InterfaceAPI interface {
+	GetInterfacesOfTypes(types ...string) ([]Interface, error)
+	GetEthInterfaces() ([]Interface, error)
+	GetBridgeInterfaces() ([]Interface, error)
+	GetVlanInterfaces(baseIntf string) ([]Interface, error)
+	GetVLANInterface(vlan int) (Interface, error)
+	GetVLANInterfaceOnBase(baseIntf string, vlan int) (Interface, error)
+	AddVLANInterface(intf Interface) (string, error)
+	EnableInterface(id string) error
+	DisableInterface(id string) error
+	SetInterfaceComment(id string, comment string) error
+	SetInterfaceName(id string, newName string) error
+	GetArpTable() ([]ArpEntry, error)
+	GetInterfaceArpTable(baseIntf string) ([]ArpEntry, error)
+	ArpLookupByIP(ipv4 string) (ArpEntry, error)
+	ArpLookupByMAC(mac string) (ArpEntry, error)
+	GetNeighborInterface(iface string) (NeighborInterface, error)
+	ModifyNeighbor(id string, action string) error
+}
+
+// IPAPI covers IP addressing, pools, routes, DHCP, DNS and IP services.
+type IPAPI interface {
+	GetIPv4Table() ([]IPv4Address, error)
+	GetInterfaceIPv4Table(baseIntf string) ([]IPv4Address, error)
+	GetCustomerIPv4Subnets(vlan int) ([]IPv4Address, error)
+	AddIPv4Address(addr IPv4Address) (string, error)
+	ModifyIPv4Address(id string, action string) error
+	GetIPv4Pools() ([]IPv4Pool, error)
+	GetIPv4Pool(name string) ([]IPv4Pool, error)
+	AddIPv4Pool(pool IPv4Pool) (string, error)
+	GetIPv4Routes(limiters []string) ([]IPv4Route, error)
+	FindMatchingIPv4Routes(routes []IPv4Route, netToMatch *net.IPNet) ([]IPv4Route, error)
+	ModifyIPv4Route(id string, action string) error
+	GetIPv6Settings() (IPv6Settings, error)
+	GetDhcpv4Networks() ([]DHCP4Network, error)
+	AddDhcpv4Network(s DHCP4Network) (string, error)
+	GetDhcpv4Servers() ([]DHCPv4Server, error)
+	GetDhcp4ServerByName(name string) ([]DHCPv4Server, error)
+	GetDhcp4ServerByIntf(intf string) ([]DHCPv4Server, error)
+	AddDhcpv4Server(s DHCPv4Server) (string, error)
+	SetDhcpv4ServerDisable(id string, disabled bool) error
+	GetDNS() (DNS, error)
+	SetDNS(d DNS) error
+	FlushDNS() error
+	GetIPServices() ([]IPService, error)
+	SetIPService(id string, disabled bool, port int, address string, cert string, tlsVersion string) error
+	SetIPServiceDisable(id string, disabled bool) error
+}
+
+// PPPAPI covers PPP secrets, active connections and PPPoE servers.
+type PPPAPI interface {
+	GetPPPSecrets() ([]PPPSecret, error)
+	GetPPPSecretByName(name string) (PPPSecret, error)
+	AddPPPSecret(secret PPPSecret) (string, error)
+	UpdatePPPSecret(secret PPPSecret) (string, error)
+	RemovePPPSecret(id string) error
+	RemovePPPSecretByName(name string) error
+	GetPPPActiveConnections() ([]PPPActive, error)
+	GetPPPActiveConnectionByName(name string) (PPPActive, error)
+	GetPPPoEServers(intf string) ([]PPPoEServer, error)
+	RemovePPPoEServer(id string) error
+}
+
+// QueueAPI covers queue trees and simple queues.
+type QueueAPI interface {
+	GetQueueTreeByName(name string) (QueueTree, error)
+	GetQueueTree(parent string) ([]QueueTree, error)
+	GetQueueTreeAll() ([]QueueTree, error)
+	AddQueueTree(queue *QueueTree) error
+	AddQueueTreeSingle(queue QueueTree) (string, error)
+	RemoveQueueTree(queue QueueTree, removeChildren bool) error
+	RemoveQueueTreeByName(name string) error
+	GetSimpleQueues(target string) ([]SimpleQueue, error)
+	RemoveSimpleQueue(ID string) error
+}
+
+// RoutingAPI covers dynamic routing protocols.
+type RoutingAPI interface {
+	GetOspf2LsaTable() ([]OSPF2LSA, error)
+}
+
+// SystemAPI covers system information, packages, users, scripts, files and services.
+type SystemAPI interface {
+	GetSystemResources() (Resources, error)
+	GetSystemRouterboard() (Routerboard, error)
+	GetSystemId() (string, error)
+	GetSystemLicense() (License, error)
+	CreateExport(targetname string, minFreeSpace int) error
+	ExportConfig(base string, filename string, hideSensitive bool) error
+	ExportConfigToWriter(base string, w io.Writer, hideSensitive bool) error
+	Fetch(filename string, hideSensitive bool) error
+	GetPackages() ([]Package, error)
+	IsPackageEnabled(name string) (bool, error)
+	GetUpdateInfo() (PackageUpdate, error)
+	CheckForUpdates() (PackageUpdate, error)
+	SetUpdateChannel(channel string) error
+	DownloadUpdates() (PackageUpdate, error)
+	InstallUpdates() (PackageUpdate, error)
+	GetUsers() ([]User, error)
+	GetUserByName(name string) (User, error)
+	AddUser(user User) (string, error)
+	UpdateUser(user User) (string, error)
+	UpdateUserPasswordByID(ID, password string) (string, error)
+	UpdateUserPasswordByName(username, password string) (string, error)
+	RemoveUser(id string) error
+	RemoveUserByName(name string) error
+	GetGroups() ([]Group, error)
+	GetGroupByName(name string) (Group, error)
+	AddGroup(g Group) (string, error)
+	UpdateGroup(g Group) (string, error)
+	RemoveGroup(id string) error
+	RemoveGroupByName(name string) error
+	GetScripts() ([]Script, error)
+	AddScript(s Script) (string, error)
+	UpdateScript(s Script) (string, error)
+	RemoveScript(id string) error
+	GetScheduler() ([]Schedule, error)
+	AddSchedule(s Schedule) (string, error)
+	UpdateSchedule(s Schedule) (string, error)
+	RemoveSchedule(id string) error
+	GetAllFiles() ([]File, error)
+	AddFile(name, contents string) error
+	RemoveFileByName(name string) error
+	RemoveFileByID(id string) error
+	ReadFile(name string) ([]byte, error)
+	DownloadFile(name string, w io.Writer) error
+	UploadFile(name string, r io.Reader) error
+	ImportScript(name string, opts ImportOptions) (ImportResult, error)
+	ImportString(script string, opts ImportOptions) (ImportResult, error)
+	GetCertificates() ([]Certificate, error)
+	CertificateImport(name, filename, passphrase string) (CertImportResults, error)
+	SetCertificateName(id string, name string) error
+	RemoveCertificate(id string) error
+	GetNTPClient() (any, error)
+	SetNTPClient(ntp any) error
+	GetSNMP() (SNMP, error)
+	SetSNMP(s SNMP) error
+	GetSNMPCommunities() ([]SNMPCommunity, error)
+	AddSNMPCommunity(community SNMPCommunity) (string, error)
+	UpdateSNMPCommunity(community SNMPCommunity) (string, error)
+	RemoveSNMPCommunity(id string) error
+	GetAAA() (AAA, error)
+	SetAAA(a AAA) (string, error)
+	GetRadius() ([]RadiusServer, error)
+	AddRadius(r RadiusServer, placeBefore string) (string, error)
+	RemoveRadius(id string) error
+}
+
+// RouterOS is the full set of methods offered by *Client.
+type RouterOS interface {
+	CommandAPI
+	FirewallAPI
+	InterfaceAPI
+	IPAPI
+	PPPAPI
+	QueueAPI
+	RoutingAPI
+	SystemAPI
+}
+
+var _ RouterOS = (*Client)(nil)
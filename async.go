@@ -10,6 +10,14 @@ type replyCloser interface {
 	close(err error)
 }
 
+type replyDescriber interface {
+	describe() string
+}
+
+type replyWaiter interface {
+	finished() <-chan struct{}
+}
+
 // Async starts asynchronous mode and returns immediately.
 func (c *Client) Async() <-chan error {
 	c.mu.Lock()
@@ -23,12 +31,17 @@ func (c *Client) Async() <-chan error {
 	}
 	c.async = true
 	c.tags = make(map[string]sentenceProcessor)
+	c.asyncDone = make(chan struct{})
 	go c.asyncLoopChan(errC)
+	if c.KeepaliveInterval > 0 {
+		go c.keepaliveLoop(c.asyncDone)
+	}
 	return errC
 }
 
 func (c *Client) asyncLoopChan(errC chan<- error) {
 	defer close(errC)
+	defer close(c.asyncDone)
 	// If c.Close() has been called, c.closing will be true, and
 	// err will be “use of closed network connection”. Ignore that error.
 	err := c.asyncLoop()
@@ -43,10 +56,15 @@ func (c *Client) asyncLoopChan(errC chan<- error) {
 }
 
 func (c *Client) asyncLoop() error {
-	var lastErr error
+	// Errors are tracked per tag since replies to pipelined commands may be interleaved
+	lastErr := make(map[string]error)
 	for {
 		sen, err := c.r.ReadSentence()
 		if err != nil {
+			// If the keepalive closed the connection, report that rather than the read error
+			if c.lost.Load() {
+				err = ErrConnectionLost
+			}
 			c.closeTags(err)
 			return err
 		}
@@ -59,14 +77,15 @@ func (c *Client) asyncLoop() error {
 		}
 
 		done, err := r.processSentence(sen)
-		if err != nil {
-			lastErr = err
+		if err != nil && lastErr[sen.Tag] == nil {
+			lastErr[sen.Tag] = err
 		}
 		if done {
 			c.mu.Lock()
 			delete(c.tags, sen.Tag)
 			c.mu.Unlock()
-			closeReply(r, lastErr)
+			closeReply(r, lastErr[sen.Tag])
+			delete(lastErr, sen.Tag)
 		}
 	}
 }
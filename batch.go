@@ -0,0 +1,121 @@
+package gotik
+
+// DefaultBatchWindow is the number of commands a Batch keeps in flight when Window is not set.
+const DefaultBatchWindow = 32
+
+// Batch pipelines many commands over a single connection using the async multiplexer.
+// Rather than waiting for each reply before sending the next command, up to Window
+// tagged commands are kept in flight at once, which hides the round-trip time on slow links.
+// Create a Batch with c.Batch(), queue commands with Add or AddArgs and then call Run.
+type Batch struct {
+	// Window is the maximum number of commands in flight at once.  If zero, DefaultBatchWindow is used.
+	Window int
+	// StopOnError stops sending further commands once any command returns an error.  Commands
+	// already in flight are still collected; commands not yet sent are marked with ErrBatchAborted.
+	StopOnError bool
+
+	c         *Client
+	sentences [][]string
+}
+
+// BatchResult is the outcome of one command in a Batch.
+type BatchResult struct {
+	Sentence []string
+	Reply    *Reply
+	Err      error
+}
+
+// Batch returns a new, empty Batch for the client.
+func (c *Client) Batch() *Batch {
+	return &Batch{c: c}
+}
+
+// Add queues a command to be run.  The words are the same as those passed to Run.
+func (b *Batch) Add(sentence ...string) {
+	b.AddArgs(sentence)
+}
+
+// AddArgs queues a command to be run.  The words are the same as those passed to RunArgs.
+func (b *Batch) AddArgs(sentence []string) {
+	b.sentences = append(b.sentences, sentence)
+}
+
+// Len returns the number of commands queued in the batch.
+func (b *Batch) Len() int {
+	return len(b.sentences)
+}
+
+// Run sends all queued commands and waits for their replies.  If the client is not yet in
+// async mode, Async() is started.  The results are returned in the same order the commands
+// were added.  The returned error is the first error, in command order, of any command.
+func (b *Batch) Run() ([]BatchResult, error) {
+	type pending struct {
+		index int
+		a     *asyncReply
+	}
+	var (
+		firstErr error
+		stopped  bool
+		next     int
+	)
+	if !b.c.async {
+		b.c.Async()
+	}
+	window := b.Window
+	if window <= 0 {
+		window = DefaultBatchWindow
+	}
+	results := make([]BatchResult, len(b.sentences))
+	for i := range b.sentences {
+		results[i].Sentence = b.sentences[i]
+	}
+	fail := func(i int, err error) {
+		results[i].Err = err
+		if firstErr == nil {
+			firstErr = err
+		}
+		if b.StopOnError {
+			stopped = true
+		}
+	}
+	inFlight := make([]pending, 0, window)
+	for {
+		for !stopped && next < len(b.sentences) && len(inFlight) < window {
+			if r, handled, err := b.c.intercept(b.sentences[next]); handled {
+				if err != nil {
+					fail(next, err)
+				} else {
+					results[next].Reply = r
+				}
+				next++
+				continue
+			}
+			a, err := b.c.sendAsync(b.sentences[next])
+			if err != nil {
+				// A write failure means the connection is unusable, so stop regardless of StopOnError
+				fail(next, err)
+				stopped = true
+			} else {
+				inFlight = append(inFlight, pending{index: next, a: a})
+			}
+			next++
+		}
+		if len(inFlight) == 0 {
+			break
+		}
+		p := inFlight[0]
+		inFlight = inFlight[1:]
+		for range p.a.reC {
+		}
+		b.c.afterCommand(b.sentences[p.index], &p.a.Reply, p.a.err)
+		if p.a.err != nil {
+			fail(p.index, p.a.err)
+		} else {
+			results[p.index].Reply = &p.a.Reply
+		}
+	}
+	for ; next < len(b.sentences); next++ {
+		results[next].Err = ErrBatchAborted
+	}
+	return results, firstErr
+}
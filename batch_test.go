@@ -0,0 +1,70 @@
+package gotik_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jjcinaz/gotik"
+)
+
+func TestBatch(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+
+	go func() {
+		defer s.Close()
+		s.readSentence(t, "/ip/address/add @r1 [{`address` `10.0.0.1/24`}]")
+		s.readSentence(t, "/ip/address/add @r2 [{`address` `10.0.0.2/24`}]")
+		s.writeSentence(t, "!done", ".tag=r2", "=ret=*2")
+		s.writeSentence(t, "!done", ".tag=r1", "=ret=*1")
+		s.readSentence(t, "/ip/address/add @r3 [{`address` `10.0.0.3/24`}]")
+		s.writeSentence(t, "!trap", ".tag=r3", "=message=already have such address")
+		s.writeSentence(t, "!done", ".tag=r3")
+	}()
+
+	b := c.Batch()
+	b.Window = 2
+	b.Add("/ip/address/add", "=address=10.0.0.1/24")
+	b.Add("/ip/address/add", "=address=10.0.0.2/24")
+	b.Add("/ip/address/add", "=address=10.0.0.3/24")
+	results, err := b.Run()
+	if err == nil || err.Error() != "from RouterOS device: already have such address" {
+		t.Fatalf("Run()=%v; want trap error", err)
+	}
+	for i, want := range []string{"*1", "*2"} {
+		if results[i].Err != nil {
+			t.Fatalf("#%d: %v", i, results[i].Err)
+		}
+		if got := results[i].Reply.Done.Map["ret"]; got != want {
+			t.Fatalf("#%d: ret=%s; want %s", i, got, want)
+		}
+	}
+	if results[2].Err == nil {
+		t.Fatalf("#2: succeeded; want error")
+	}
+}
+
+func TestBatchStopOnError(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+
+	go func() {
+		defer s.Close()
+		s.readSentence(t, "/user/remove @r1 [{`.id` `*1`}]")
+		s.writeSentence(t, "!trap", ".tag=r1", "=message=no such item")
+		s.writeSentence(t, "!done", ".tag=r1")
+	}()
+
+	b := c.Batch()
+	b.Window = 1
+	b.StopOnError = true
+	b.Add("/user/remove", "=.id=*1")
+	b.Add("/user/remove", "=.id=*2")
+	results, err := b.Run()
+	if err == nil {
+		t.Fatalf("Run() succeeded; want error")
+	}
+	if !errors.Is(results[1].Err, gotik.ErrBatchAborted) {
+		t.Fatalf("#1: %v; want %v", results[1].Err, gotik.ErrBatchAborted)
+	}
+}
@@ -5,8 +5,17 @@ import "github.com/jjcinaz/gotik/proto"
 // chanReply is shared between ListenReply and AsyncReply.
 type chanReply struct {
 	tag string
+	cmd string // first word of the command, for reporting
 	err error
 	reC chan *proto.Sentence
+	// done is closed along with reC, for waiting on the reply without taking its sentences
+	done chan struct{}
+}
+
+// init makes the channels, with room for queueSize sentences in reC.
+func (a *chanReply) init(queueSize int) {
+	a.reC = make(chan *proto.Sentence, queueSize)
+	a.done = make(chan struct{})
 }
 
 // Err returns the first error that happened processing sentences with tag.
@@ -19,4 +28,15 @@ func (a *chanReply) close(err error) {
 		a.err = err
 	}
 	close(a.reC)
+	close(a.done)
+}
+
+// describe returns the tag and command, for example "l3 /tool/torch".
+func (a *chanReply) describe() string {
+	return a.tag + " " + a.cmd
+}
+
+// finished returns a channel which is closed once the reply has ended.
+func (a *chanReply) finished() <-chan struct{} {
+	return a.done
 }
@@ -1,6 +1,7 @@
 package gotik
 
 import (
+	"context"
 	"crypto/md5"
 	"crypto/tls"
 	"encoding/hex"
@@ -10,6 +11,7 @@ import (
 	"regexp"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/jjcinaz/gotik/proto"
@@ -18,6 +20,14 @@ import (
 // Client is a RouterOS API client.
 type Client struct {
 	Queue int
+	// KeepaliveInterval, if non-zero, causes a cheap command to be sent at this interval while
+	// in async mode so that a silently dead connection is detected.  It must be set before Async().
+	KeepaliveInterval time.Duration
+	// KeepaliveMaxMissed is the number of keepalive intervals which may pass without a reply
+	// before the connection is declared lost.  If zero, DefaultKeepaliveMaxMissed is used.
+	KeepaliveMaxMissed int
+	// QuitOnShutdown causes Shutdown to send /quit to the device before closing the connection.
+	QuitOnShutdown bool
 
 	rwc                  io.ReadWriteCloser
 	serverName           string // dns name or IP address
@@ -27,8 +37,19 @@ type Client struct {
 	w                    proto.Writer
 	closing              bool
 	async                bool
+	asyncDone            chan struct{} // closed when the async loop ends
+	lost                 atomic.Bool   // set when keepalive declares the connection dead
 	nextTag              int64
+	dryRun               bool
+	readOnly             bool
+	journal              *Journal
+	tx                   *Tx    // active transaction, if any
+	journalRouter        string // system identity, recorded in journal entries
+	username             string
+	plan                 []PlannedChange // changes captured in dry-run mode
+	nextDryID            int
 	tags                 map[string]sentenceProcessor
+	redial               func() (*Client, error) // makes a new connection like this one, set by the Dial functions
 	mu                   sync.Mutex
 	cachedResources      Resources // cached at initial login
 	majorVersion         int
@@ -81,6 +102,9 @@ func Dial(address, username, password string) (*Client, error) {
 	c, err = newClientAndLogin(conn, username, password, false)
 	if err == nil {
 		c.serverName = address
+		c.redial = func() (*Client, error) {
+			return Dial(address, username, password)
+		}
 	}
 	return c, err
 }
@@ -99,6 +123,9 @@ func DialTimeout(address, username, password string, timeout time.Duration) (*Cl
 	c, err = newClientAndLogin(conn, username, password, false)
 	if err == nil {
 		c.serverName = address
+		c.redial = func() (*Client, error) {
+			return DialTimeout(address, username, password, timeout)
+		}
 	}
 	return c, err
 }
@@ -117,6 +144,9 @@ func DialTLS(address, username, password string, tlsConfig *tls.Config) (*Client
 	c, err = newClientAndLogin(conn, username, password, true)
 	if err == nil {
 		c.serverName = address
+		c.redial = func() (*Client, error) {
+			return DialTLS(address, username, password, tlsConfig)
+		}
 	}
 	return c, err
 }
@@ -137,6 +167,9 @@ func DialTLSTimeout(address, username, password string, tlsConfig *tls.Config, t
 	c, err = newClientAndLogin(conn, username, password, true)
 	if err == nil {
 		c.serverName = address
+		c.redial = func() (*Client, error) {
+			return DialTLSTimeout(address, username, password, tlsConfig, timeout)
+		}
 	}
 	return c, err
 }
@@ -212,6 +245,89 @@ func (c *Client) Close() {
 	_ = c.rwc.Close()
 }
 
+// Shutdown gracefully closes the connection to the RouterOS device.  In async mode, a /cancel
+// is sent for every outstanding command (listeners, tools such as /tool/torch, etc.) and Shutdown
+// waits for each of them to finish until ctx is done.  If QuitOnShutdown is set, /quit is then
+// sent before the connection is closed.  The connection is always closed; if some commands did
+// not finish in time, a *ShutdownError listing them is returned.
+func (c *Client) Shutdown(ctx context.Context) error {
+	c.mu.Lock()
+	if c.closing {
+		c.mu.Unlock()
+		return nil
+	}
+	tags := make([]string, 0, len(c.tags))
+	done := make([]<-chan struct{}, 0, len(c.tags))
+	for tag, r := range c.tags {
+		tags = append(tags, tag)
+		if d, ok := r.(replyWaiter); ok {
+			done = append(done, d.finished())
+		}
+	}
+	c.mu.Unlock()
+
+	for _, tag := range tags {
+		// The replies to the cancels are not needed; the cancelled commands finishing is what counts
+		c.RunAsync("/cancel", "=tag="+tag)
+	}
+WAIT:
+	for _, d := range done {
+		select {
+		case <-ctx.Done():
+			break WAIT
+		case <-d:
+		}
+	}
+	unfinished := c.unfinishedTags(tags)
+	if c.QuitOnShutdown {
+		// Untagged, so the async loop ignores the reply; the device ends the session anyway
+		c.w.BeginSentence()
+		c.w.WriteWord("/quit")
+		_ = c.w.EndSentence()
+	}
+	c.Close()
+	if len(unfinished) > 0 {
+		return &ShutdownError{Unfinished: unfinished}
+	}
+	return nil
+}
+
+// unfinishedTags returns a description of each of tags which is still outstanding.
+func (c *Client) unfinishedTags(tags []string) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	unfinished := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if r, ok := c.tags[tag]; ok {
+			if d, ok := r.(replyDescriber); ok {
+				unfinished = append(unfinished, d.describe())
+			} else {
+				unfinished = append(unfinished, tag)
+			}
+		}
+	}
+	return unfinished
+}
+
+// SetReadOnly turns the read-only guard on or off.  While it is on, every outgoing command is
+// classified by its command word and only commands which read from the device (print, get,
+// monitor, listen, cancel, etc.) are let through.  Anything else, whether it changes the
+// configuration (add, set, remove, /password, make-static...) or is disruptive (reboot, install,
+// import, run...), is rejected with ErrReadOnly before anything is written.  This is meant for
+// monitoring services which must never change the device.
+func (c *Client) SetReadOnly(enabled bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.readOnly = enabled
+}
+
+// ReadOnly returns true if the read-only guard is on.
+func (c *Client) ReadOnly() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.readOnly
+}
+
 // Login runs the /login command. Dial and DialTLS call this automatically.
 func (c *Client) Login(username, password string) error {
 	var (
@@ -219,6 +335,9 @@ func (c *Client) Login(username, password string) error {
 		err error
 		b   []byte
 	)
+	c.mu.Lock()
+	c.username = username
+	c.mu.Unlock()
 	if c.isTLS || c.useInsecureCleartext {
 		// RouterOS post v6.43 has new authentication method and wants the login/pass (in cleartext) on
 		// the first command.  We only do this if it is a TLS connection.
@@ -0,0 +1,85 @@
+package gotik
+
+import "strings"
+
+// readVerbs are the command words which only read from the device.  Any other command may
+// change it, so the read-only guard lets through only these, and dry-run, transactions and
+// the journal handle every other command.
+var readVerbs = map[string]bool{
+	"print":             true,
+	"get":               true,
+	"getall":            true,
+	"read":              true,
+	"monitor":           true,
+	"monitor-traffic":   true,
+	"listen":            true,
+	"cancel":            true,
+	"login":             true,
+	"quit":              true,
+	"torch":             true,
+	"check-for-updates": true,
+}
+
+// splitCommand splits a command word like "/ip/firewall/filter/add" into its
+// menu path ("/ip/firewall/filter") and verb ("add").
+func splitCommand(cmd string) (path, verb string) {
+	i := strings.LastIndex(cmd, "/")
+	if i < 0 {
+		return "", cmd
+	}
+	return cmd[:i], cmd[i+1:]
+}
+
+// isReadCommand returns true if the sentence is a command which only reads from the device.
+func isReadCommand(sentence []string) bool {
+	if len(sentence) == 0 {
+		return true
+	}
+	_, verb := splitCommand(sentence[0])
+	return readVerbs[verb]
+}
+
+// guard returns ErrReadOnly if the client is read-only and sentence may change the device.
+func (c *Client) guard(sentence []string) error {
+	c.mu.Lock()
+	readOnly := c.readOnly
+	c.mu.Unlock()
+	if readOnly && !isReadCommand(sentence) {
+		return ErrReadOnly
+	}
+	return nil
+}
+
+// intercept is called with every command before it is written to the device.  If handled
+// is true, the command must not be sent and r and err are to be used as its result.
+func (c *Client) intercept(sentence []string) (r *Reply, handled bool, err error) {
+	if err = c.guard(sentence); err != nil {
+		return nil, true, err
+	}
+	c.mu.Lock()
+	dryRun := c.dryRun
+	tx := c.tx
+	c.mu.Unlock()
+	if isReadCommand(sentence) {
+		return nil, false, nil
+	}
+	if dryRun {
+		return c.planChange(sentence), true, nil
+	}
+	if tx != nil {
+		r, err = tx.run(sentence)
+		return r, true, err
+	}
+	return nil, false, nil
+}
+
+// afterCommand is called with every command which was sent to the device, once its reply
+// has been received.
+func (c *Client) afterCommand(sentence []string, r *Reply, err error) {
+	c.mu.Lock()
+	j := c.journal
+	c.mu.Unlock()
+	if j != nil && !isReadCommand(sentence) {
+		j.record(c, sentence, r, err)
+	}
+}
@@ -1,17 +1,22 @@
 package gotik
 
+import "strings"
+
 // ExportConfig will export the configuration to a file on the router.
 // The export starts at the "base" level.  Pass "/" or "" for the entire configuration.
 // If you just wanted the IPv6 configuration, then pass "/ipv6", for example.
 // The filename is the name under which to store on the router.  It will have the extension ".rsc"
 // added in all cases.  Pass bool to hideSensitive to hide sensitive items in the export (true)
 // or to expose them (false).
-// This function does not currently pull down the configuration file.  It stays on the router.
+// The file stays on the router; use ExportConfigToWriter to retrieve the export instead.
 func (c *Client) ExportConfig(base string, filename string, hideSensitive bool) error {
-	a := []string{"/export", "=file=" + filename, "=compact="}
-	if hideSensitive {
-		a = append(a, "=hide-sensitive=yes")
-	} else {
+	a := []string{strings.TrimSuffix(base, "/") + "/export", "=file=" + filename, "=compact="}
+	// RouterOS 6 shows sensitive values unless asked not to, RouterOS 7 hides them unless asked to show them
+	if c.majorVersion >= 7 {
+		if !hideSensitive {
+			a = append(a, "=show-sensitive=yes")
+		}
+	} else if hideSensitive {
 		a = append(a, "=hide-sensitive=yes")
 	}
 	_, err := c.Run(a...)
@@ -0,0 +1,121 @@
+package gotik
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// confirmPolicy is the policy given to the revert script and its scheduler entry.  Resetting
+// the configuration needs all of them.
+var confirmPolicy = []string{"ftp", "reboot", "read", "write", "policy", "test", "password", "sniff", "sensitive", "romon"}
+
+// CommitConfirm applies changes with a safety net against locking ourselves out of the router.
+//
+// Before calling changes, it exports the whole configuration to a file on the router, then
+// installs a script which resets the configuration and loads that export, along with a
+// scheduler entry which runs the script once timeout has passed.  After changes returns, a
+// new connection is made with the address and credentials used to create this client.  Only
+// when that connection succeeds are the scheduler entry, the script and the export removed.
+//
+// If changes returns an error, or the router cannot be reached again before timeout passes,
+// the revert is left in place and the router restores its prior configuration by itself.
+// Users are kept across the revert.  The client must have been created with Dial, DialTimeout,
+// DialTLS or DialTLSTimeout so that it can reconnect.
+//
+//	err := c.CommitConfirm(5*time.Minute, func() error {
+//		return c.AddFilterRule(rule)
+//	})
+func (c *Client) CommitConfirm(timeout time.Duration, changes func() error) error {
+	c.mu.Lock()
+	redial := c.redial
+	c.mu.Unlock()
+	if redial == nil {
+		return ErrCannotRedial
+	}
+	if timeout < time.Second {
+		return fmt.Errorf("commit-confirm timeout %s is too short", timeout)
+	}
+
+	detail, err := c.Run("/system/clock/print")
+	if err != nil {
+		return err
+	}
+	if len(detail.Re) == 0 {
+		return fmt.Errorf("unable to read router clock")
+	}
+	clock := detail.Re[0].Map
+
+	name := fmt.Sprintf("gotik-confirm-%d", time.Now().Unix())
+	if err = c.ExportConfig("/", name, false); err != nil {
+		return err
+	}
+	export, err := c.findFile(name + ".rsc")
+	if err != nil {
+		return err
+	}
+
+	scriptID, err := c.AddScript(Script{
+		Name:    name,
+		Comment: "gotik commit-confirm revert",
+		Policy:  confirmPolicy,
+		Source: fmt.Sprintf("/system scheduler remove [find name=\"%s\"]\n"+
+			"/system reset-configuration no-defaults=yes keep-users=yes skip-backup=yes run-after-reset=\"%s\"\n",
+			name, export.Name),
+	})
+	if err != nil {
+		_ = c.RemoveFileByID(export.ID)
+		return err
+	}
+	// the first run of a scheduler entry whose start time has passed is one interval later
+	armed := time.Now()
+	scheduleID, err := c.AddSchedule(Schedule{
+		Name:      name,
+		Comment:   "gotik commit-confirm revert",
+		Policy:    confirmPolicy,
+		StartDate: clock["date"],
+		StartTime: clock["time"],
+		Interval:  timeout,
+		OnEvent:   "/system script run \"" + name + "\"",
+	})
+	if err != nil {
+		_ = c.RemoveScript(scriptID)
+		_ = c.RemoveFileByID(export.ID)
+		return err
+	}
+
+	if err = changes(); err != nil {
+		return fmt.Errorf("changes failed, router will revert within %s: %w", timeout, err)
+	}
+
+	check, err := redial()
+	if err != nil {
+		return fmt.Errorf("router unreachable after changes, it will revert within %s: %w", timeout, err)
+	}
+	defer check.Close()
+	if time.Since(armed) >= timeout {
+		return fmt.Errorf("commit-confirm timeout of %s expired before the router was reached again", timeout)
+	}
+	if err = check.RemoveSchedule(scheduleID); err != nil {
+		return err
+	}
+	if err = check.RemoveScript(scriptID); err != nil {
+		return err
+	}
+	return check.RemoveFileByID(export.ID)
+}
+
+// findFile returns the file whose name is name or ends in "/"+name, since exports may land
+// in a directory such as "flash/".
+func (c *Client) findFile(name string) (File, error) {
+	files, err := c.GetAllFiles()
+	if err != nil {
+		return File{}, err
+	}
+	for _, f := range files {
+		if f.Name == name || strings.HasSuffix(f.Name, "/"+name) {
+			return f, nil
+		}
+	}
+	return File{}, ErrNotFound
+}
@@ -0,0 +1,110 @@
+package gotik_test
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/jjcinaz/gotik"
+	"github.com/jjcinaz/gotik/proto"
+)
+
+// expectCommand reads a sentence and checks only its command word, for commands whose
+// arguments vary from run to run.
+func (f *fakeServer) expectCommand(t *testing.T, word string) *proto.Sentence {
+	sen, err := f.r.ReadSentence()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sen.Word != word {
+		t.Fatalf("Command (%s); want (%s)", sen.String(), word)
+	}
+	t.Logf("< %s\n", sen)
+	return sen
+}
+
+// acceptRouter accepts a connection on ln and answers the login made by the Dial functions.
+func acceptRouter(t *testing.T, ln net.Listener) *fakeServer {
+	conn, err := ln.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &fakeServer{proto.NewReader(conn), proto.NewWriter(conn), conn}
+	s.readSentence(t, "/login @ [{`name` `admin`} {`password` `secret`}]")
+	s.writeSentence(t, "!done")
+	s.readSentence(t, "/system/resource/print @ []")
+	s.writeSentence(t, "!re", "=version=7.13 (stable)")
+	s.writeSentence(t, "!done")
+	return s
+}
+
+func TestCommitConfirm(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	go func() {
+		s := acceptRouter(t, ln)
+		defer s.Close()
+		s.readSentence(t, "/system/clock/print @ []")
+		s.writeSentence(t, "!re", "=time=10:00:00", "=date=2024-01-02")
+		s.writeSentence(t, "!done")
+		sen := s.expectCommand(t, "/export")
+		if sen.Map["show-sensitive"] != "yes" {
+			t.Errorf("export hides sensitive values: %s", sen)
+		}
+		s.writeSentence(t, "!done")
+		export := sen.Map["file"] + ".rsc"
+		s.readSentence(t, "/file/print @ []")
+		s.writeSentence(t, "!re", "=.id=*A", "=name=flash/"+export)
+		s.writeSentence(t, "!done")
+		s.expectCommand(t, "/system/script/add")
+		s.writeSentence(t, "!done", "=ret=*1")
+		sen = s.expectCommand(t, "/system/scheduler/add")
+		if sen.Map["start-time"] != "10:00:00" || sen.Map["interval"] != "2m0s" {
+			t.Errorf("scheduler not armed for the timeout: %s", sen)
+		}
+		s.writeSentence(t, "!done", "=ret=*2")
+		s.readSentence(t, "/ip/address/add @ [{`address` `10.0.0.1/24`}]")
+		s.writeSentence(t, "!done", "=ret=*5")
+
+		check := acceptRouter(t, ln)
+		defer check.Close()
+		check.readSentence(t, "/system/scheduler/remove @ [{`.id` `*2`}]")
+		check.writeSentence(t, "!done")
+		check.readSentence(t, "/system/script/remove @ [{`.id` `*1`}]")
+		check.writeSentence(t, "!done")
+		check.readSentence(t, "/file/remove @ [{`.id` `*A`}]")
+		check.writeSentence(t, "!done")
+	}()
+
+	c, err := gotik.Dial(ln.Addr().String(), "admin", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	err = c.CommitConfirm(2*time.Minute, func() error {
+		_, err := c.Run("/ip/address/add", "=address=10.0.0.1/24")
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCommitConfirmCannotRedial(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+	defer s.Close()
+
+	err := c.CommitConfirm(time.Minute, func() error {
+		t.Fatal("changes applied without a way to confirm them")
+		return nil
+	})
+	if !errors.Is(err, gotik.ErrCannotRedial) {
+		t.Fatalf("CommitConfirm()=%v; want %v", err, gotik.ErrCannotRedial)
+	}
+}
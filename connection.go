@@ -0,0 +1,292 @@
+package gotik
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Connection is an entry of the connection tracking table, /ip/firewall/connection.
+type Connection struct {
+	ID              string
+	Protocol        string
+	SrcAddress      string
+	SrcPort         int
+	DstAddress      string
+	DstPort         int
+	ReplySrcAddress string
+	ReplySrcPort    int
+	ReplyDstAddress string
+	ReplyDstPort    int
+	TCPState        string
+	Timeout         time.Duration
+	OrigBytes       int64
+	ReplBytes       int64
+	OrigPackets     int64
+	ReplPackets     int64
+	ConnectionMark  string
+	ConnectionType  string
+	Assured         bool
+	SeenReply       bool
+	Confirmed       bool
+	Dying           bool
+	Fasttrack       bool
+	SrcNAT          bool
+	DstNAT          bool
+}
+
+func (conn *Connection) String() string {
+	return fmt.Sprintf("%s %s -> %s %s timeout=%s bytes=%d/%d", conn.Protocol,
+		net.JoinHostPort(conn.SrcAddress, strconv.Itoa(conn.SrcPort)),
+		net.JoinHostPort(conn.DstAddress, strconv.Itoa(conn.DstPort)),
+		conn.TCPState, conn.Timeout, conn.OrigBytes, conn.ReplBytes)
+}
+
+// splitAddrPort splits a connection address such as "10.0.0.1:443".  Addresses without a
+// port, as for ICMP, are returned with port 0.
+func splitAddrPort(s string) (string, int) {
+	host, port, err := net.SplitHostPort(s)
+	if err != nil {
+		return s, 0
+	}
+	return host, parseInt(port)
+}
+
+func parseConnection(props map[string]string) Connection {
+	entry := Connection{
+		ID:             props[".id"],
+		Protocol:       props["protocol"],
+		TCPState:       props["tcp-state"],
+		Timeout:        parseDuration(props["timeout"]),
+		OrigBytes:      parseInt64(props["orig-bytes"]),
+		ReplBytes:      parseInt64(props["repl-bytes"]),
+		OrigPackets:    parseInt64(props["orig-packets"]),
+		ReplPackets:    parseInt64(props["repl-packets"]),
+		ConnectionMark: props["connection-mark"],
+		ConnectionType: props["connection-type"],
+		Assured:        parseBool(props["assured"]),
+		SeenReply:      parseBool(props["seen-reply"]),
+		Confirmed:      parseBool(props["confirmed"]),
+		Dying:          parseBool(props["dying"]),
+		Fasttrack:      parseBool(props["fasttrack"]),
+		SrcNAT:         parseBool(props["srcnat"]),
+		DstNAT:         parseBool(props["dstnat"]),
+	}
+	entry.SrcAddress, entry.SrcPort = splitAddrPort(props["src-address"])
+	entry.DstAddress, entry.DstPort = splitAddrPort(props["dst-address"])
+	entry.ReplySrcAddress, entry.ReplySrcPort = splitAddrPort(props["reply-src-address"])
+	entry.ReplyDstAddress, entry.ReplyDstPort = splitAddrPort(props["reply-dst-address"])
+	return entry
+}
+
+// ConnectionFilter selects connections.  Empty fields match everything.  Protocol, TCPState
+// and ConnectionMark are sent to the router as queries; the address and port fields are
+// matched locally since the router keeps the port in the address.
+type ConnectionFilter struct {
+	Protocol       string
+	TCPState       string
+	ConnectionMark string
+	SrcAddress     string // an address or a CIDR network, such as 10.0.0.0/8
+	DstAddress     string // an address or a CIDR network
+	SrcPort        int
+	DstPort        int
+}
+
+// query returns the query words for the fields the router can match.
+func (f *ConnectionFilter) query() []string {
+	q := make([]string, 0, 3)
+	if len(f.Protocol) > 0 {
+		q = append(q, "?protocol="+f.Protocol)
+	}
+	if len(f.TCPState) > 0 {
+		q = append(q, "?tcp-state="+f.TCPState)
+	}
+	if len(f.ConnectionMark) > 0 {
+		q = append(q, "?connection-mark="+f.ConnectionMark)
+	}
+	return q
+}
+
+// parseNet parses an address or CIDR network into a network.  An empty string returns nil.
+func parseNet(s string) (*net.IPNet, error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+	if strings.Contains(s, "/") {
+		_, n, err := net.ParseCIDR(s)
+		return n, err
+	}
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid address %q", s)
+	}
+	bits := 8 * net.IPv6len
+	if ip4 := ip.To4(); ip4 != nil {
+		ip, bits = ip4, 8*net.IPv4len
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+}
+
+// matcher returns a function which reports whether a connection matches every field of f.
+func (f *ConnectionFilter) matcher() (func(*Connection) bool, error) {
+	src, err := parseNet(f.SrcAddress)
+	if err != nil {
+		return nil, err
+	}
+	dst, err := parseNet(f.DstAddress)
+	if err != nil {
+		return nil, err
+	}
+	return func(conn *Connection) bool {
+		switch {
+		case len(f.Protocol) > 0 && conn.Protocol != f.Protocol,
+			len(f.TCPState) > 0 && conn.TCPState != f.TCPState,
+			len(f.ConnectionMark) > 0 && conn.ConnectionMark != f.ConnectionMark,
+			f.SrcPort > 0 && conn.SrcPort != f.SrcPort,
+			f.DstPort > 0 && conn.DstPort != f.DstPort,
+			src != nil && !src.Contains(net.ParseIP(conn.SrcAddress)),
+			dst != nil && !dst.Contains(net.ParseIP(conn.DstAddress)):
+			return false
+		}
+		return true
+	}, nil
+}
+
+// GetConnections returns the connections matching f.
+func (c *Client) GetConnections(f ConnectionFilter) ([]Connection, error) {
+	match, err := f.matcher()
+	if err != nil {
+		return nil, err
+	}
+	detail, err := c.RunArgs(append([]string{"/ip/firewall/connection/print"}, f.query()...))
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]Connection, 0, len(detail.Re))
+	for _, re := range detail.Re {
+		entry := parseConnection(re.Map)
+		if match(&entry) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+// ListenConnections streams the connections matching f to fn as the router sends them, so
+// that a large connection table is never held in memory.  If fn returns an error, the print
+// is cancelled and the error is returned.  Like Listen, this puts the client in async mode.
+func (c *Client) ListenConnections(f ConnectionFilter, fn func(Connection) error) error {
+	match, err := f.matcher()
+	if err != nil {
+		return err
+	}
+	l, err := c.ListenArgs(append([]string{"/ip/firewall/connection/print"}, f.query()...))
+	if err != nil {
+		return err
+	}
+	for sen := range l.Chan() {
+		entry := parseConnection(sen.Map)
+		if !match(&entry) {
+			continue
+		}
+		if err = fn(entry); err != nil {
+			_, _ = l.Cancel()
+			for range l.Chan() {
+			}
+			return err
+		}
+	}
+	return l.Err()
+}
+
+// RemoveConnections removes the connections matching f and returns how many were removed.
+// Connections which expire before they can be removed are not counted.
+func (c *Client) RemoveConnections(f ConnectionFilter) (int, error) {
+	match, err := f.matcher()
+	if err != nil {
+		return 0, err
+	}
+	a := append([]string{"/ip/firewall/connection/print",
+		"=.proplist=.id,protocol,src-address,dst-address,tcp-state,connection-mark"}, f.query()...)
+	detail, err := c.RunArgs(a)
+	if err != nil {
+		return 0, err
+	}
+	removed := 0
+	for _, re := range detail.Re {
+		entry := parseConnection(re.Map)
+		if !match(&entry) {
+			continue
+		}
+		if _, err = c.Run("/ip/firewall/connection/remove", "=.id="+entry.ID); err != nil {
+			var devErr *DeviceError
+			if errors.As(err, &devErr) && strings.Contains(devErr.Sentence.Map["message"], "no such item") {
+				continue
+			}
+			return removed, err
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// ConnectionTracking holds the settings of /ip/firewall/connection/tracking.  Fields left
+// empty or at zero are not changed by SetConnectionTracking.
+type ConnectionTracking struct {
+	Enabled               string        `tik:"enabled"`            // yes, no or auto
+	LooseTCPTracking      string        `tik:"loose-tcp-tracking"` // yes or no; printed as true or false
+	TCPSynSentTimeout     time.Duration `tik:"tcp-syn-sent-timeout"`
+	TCPSynReceivedTimeout time.Duration `tik:"tcp-syn-received-timeout"`
+	TCPEstablishedTimeout time.Duration `tik:"tcp-established-timeout"`
+	TCPFinWaitTimeout     time.Duration `tik:"tcp-fin-wait-timeout"`
+	TCPCloseWaitTimeout   time.Duration `tik:"tcp-close-wait-timeout"`
+	TCPLastAckTimeout     time.Duration `tik:"tcp-last-ack-timeout"`
+	TCPTimeWaitTimeout    time.Duration `tik:"tcp-time-wait-timeout"`
+	TCPCloseTimeout       time.Duration `tik:"tcp-close-timeout"`
+	TCPMaxRetransTimeout  time.Duration `tik:"tcp-max-retrans-timeout"`
+	TCPUnackedTimeout     time.Duration `tik:"tcp-unacked-timeout"`
+	UDPTimeout            time.Duration `tik:"udp-timeout"`
+	UDPStreamTimeout      time.Duration `tik:"udp-stream-timeout"`
+	ICMPTimeout           time.Duration `tik:"icmp-timeout"`
+	GenericTimeout        time.Duration `tik:"generic-timeout"`
+	MaxEntries            int           // read-only
+	TotalEntries          int           // read-only
+}
+
+// GetConnectionTracking returns the connection tracking settings.
+func (c *Client) GetConnectionTracking() (ConnectionTracking, error) {
+	var settings ConnectionTracking
+	detail, err := c.Run("/ip/firewall/connection/tracking/print")
+	if err != nil {
+		return settings, err
+	}
+	if len(detail.Re) != 1 {
+		return settings, ErrNotFound
+	}
+	parseTikObject(detail.Re[0].Map, &settings)
+	settings.MaxEntries = parseInt(detail.Re[0].Map["max-entries"])
+	settings.TotalEntries = parseInt(detail.Re[0].Map["total-entries"])
+	return settings, nil
+}
+
+// SetConnectionTracking sets the connection tracking settings.
+func (c *Client) SetConnectionTracking(settings ConnectionTracking) error {
+	sentence := []string{"/ip/firewall/connection/tracking/set"}
+	s := reflect.ValueOf(settings)
+	t := s.Type()
+	for i := 0; i < s.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("tik")
+		if len(tag) == 0 {
+			continue
+		}
+		if value, managed := formatTikValue(s.Field(i)); managed {
+			sentence = append(sentence, "="+tag+"="+value)
+		}
+	}
+	_, err := c.RunArgs(sentence)
+	return err
+}
@@ -0,0 +1,80 @@
+package gotik_test
+
+import (
+	"bufio"
+	"testing"
+	"time"
+
+	"github.com/jjcinaz/gotik"
+)
+
+func TestRemoveConnections(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+	r := bufio.NewReader(s.Closer.(*conn).PipeReader)
+
+	go func() {
+		defer s.Close()
+		readWords(t, r, "/ip/firewall/connection/print =.proplist=.id,protocol,src-address,dst-address,tcp-state,connection-mark ?protocol=tcp")
+		s.writeSentence(t, "!re", "=.id=*1", "=protocol=tcp", "=src-address=10.1.2.3:40000", "=dst-address=192.0.2.1:443")
+		s.writeSentence(t, "!re", "=.id=*2", "=protocol=tcp", "=src-address=10.1.2.4:40001", "=dst-address=192.0.2.1:80")
+		s.writeSentence(t, "!re", "=.id=*3", "=protocol=tcp", "=src-address=172.16.0.1:40002", "=dst-address=192.0.2.1:443")
+		s.writeSentence(t, "!re", "=.id=*4", "=protocol=tcp", "=src-address=10.9.9.9:40003", "=dst-address=192.0.2.1:443")
+		s.writeSentence(t, "!done")
+		readWords(t, r, "/ip/firewall/connection/remove =.id=*1")
+		s.writeSentence(t, "!done")
+		readWords(t, r, "/ip/firewall/connection/remove =.id=*4")
+		s.writeSentence(t, "!trap", "=message=no such item")
+		s.writeSentence(t, "!done")
+	}()
+
+	n, err := c.RemoveConnections(gotik.ConnectionFilter{Protocol: "tcp", SrcAddress: "10.0.0.0/8", DstPort: 443})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Fatalf("removed %d; want 1", n)
+	}
+}
+
+func TestGetConnectionTracking(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+
+	go func() {
+		defer s.Close()
+		s.expectCommand(t, "/ip/firewall/connection/tracking/print")
+		s.writeSentence(t, "!re", "=enabled=auto", "=loose-tcp-tracking=true", "=tcp-established-timeout=1d",
+			"=udp-timeout=30s", "=max-entries=65536", "=total-entries=12")
+		s.writeSentence(t, "!done")
+	}()
+
+	settings, err := c.GetConnectionTracking()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if settings.Enabled != "auto" || settings.LooseTCPTracking != "true" || settings.TCPEstablishedTimeout != 24*time.Hour ||
+		settings.UDPTimeout != 30*time.Second || settings.TotalEntries != 12 {
+		t.Fatalf("unexpected settings %+v", settings)
+	}
+}
+
+func TestSetConnectionTrackingPartial(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+
+	go func() {
+		defer s.Close()
+		s.readSentence(t, "/ip/firewall/connection/tracking/set @ [{`udp-timeout` `30s`}]")
+		s.writeSentence(t, "!done")
+		s.readSentence(t, "/ip/firewall/connection/tracking/set @ [{`loose-tcp-tracking` `no`}]")
+		s.writeSentence(t, "!done")
+	}()
+
+	if err := c.SetConnectionTracking(gotik.ConnectionTracking{UDPTimeout: 30 * time.Second}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetConnectionTracking(gotik.ConnectionTracking{LooseTCPTracking: "no"}); err != nil {
+		t.Fatal(err)
+	}
+}
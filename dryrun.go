@@ -0,0 +1,81 @@
+package gotik
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jjcinaz/gotik/proto"
+)
+
+// PlannedChange is a command other than a read, captured while the client is in dry-run mode
+// instead of being sent to the device.
+type PlannedChange struct {
+	Path string   // menu path, for example "/ip/firewall/filter"
+	Verb string   // add, set, remove, enable, disable, move, unset, comment, reboot, import...
+	Args []string // the remaining words of the command, for example "=chain=input"
+	ID   string   // the synthetic ID returned for an add
+}
+
+func (p *PlannedChange) String() string {
+	a := make([]string, 0, len(p.Args)+2)
+	a = append(a, p.Path+"/"+p.Verb)
+	a = append(a, p.Args...)
+	if len(p.ID) > 0 {
+		a = append(a, "# "+p.ID)
+	}
+	return strings.Join(a, " ")
+}
+
+// SetDryRun turns dry-run mode on or off.  While in dry-run mode, read commands (print, get,
+// monitor, listen, etc.) are sent to the device as usual, but every other command, whether it
+// changes the configuration (add, set, remove, /password, make-static...) or is disruptive
+// (reboot, install, import, reset-configuration...), is not sent, including through Listen.
+// Instead, they are recorded
+// in a change plan, retrieved with ChangePlan(), and answered with a successful empty reply.
+// An add is answered with a synthetic ID such as "*DRY1" so that helpers which return the
+// ID of a new item work unchanged.  This gives every helper a dry-run for change review.
+func (c *Client) SetDryRun(enabled bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.dryRun = enabled
+}
+
+// DryRun returns true if the client is in dry-run mode.
+func (c *Client) DryRun() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.dryRun
+}
+
+// ChangePlan returns the changes captured in dry-run mode, in the order they were issued.
+func (c *Client) ChangePlan() []PlannedChange {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]PlannedChange(nil), c.plan...)
+}
+
+// ResetChangePlan discards the captured changes.  Synthetic IDs start over at *DRY1.
+func (c *Client) ResetChangePlan() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.plan = nil
+	c.nextDryID = 0
+}
+
+// planChange records sentence in the change plan and returns the reply to give the caller.
+func (c *Client) planChange(sentence []string) *Reply {
+	path, verb := splitCommand(sentence[0])
+	change := PlannedChange{Path: path, Verb: verb, Args: append([]string(nil), sentence[1:]...)}
+	done := proto.NewSentence()
+	done.Word = "!done"
+	c.mu.Lock()
+	if verb == "add" {
+		c.nextDryID++
+		change.ID = fmt.Sprintf("*DRY%d", c.nextDryID)
+		done.List = append(done.List, proto.Pair{Key: "ret", Value: change.ID})
+		done.Map["ret"] = change.ID
+	}
+	c.plan = append(c.plan, change)
+	c.mu.Unlock()
+	return &Reply{Re: make([]*proto.Sentence, 0), Done: done}
+}
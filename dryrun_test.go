@@ -0,0 +1,86 @@
+package gotik_test
+
+import (
+	"testing"
+
+	"github.com/jjcinaz/gotik"
+)
+
+func TestDryRun(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+
+	go func() {
+		defer s.Close()
+		s.readSentence(t, "/ip/firewall/filter/print @ [{`.proplist` `.id`}]")
+		s.writeSentence(t, "!re", "=.id=*A")
+		s.writeSentence(t, "!done")
+	}()
+
+	c.SetDryRun(true)
+	id, err := c.AddIPv4Address(gotik.IPv4Address{Address: "10.0.0.1/24", Interface: "ether1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "*DRY1" {
+		t.Fatalf("AddIPv4Address()=%s; want *DRY1", id)
+	}
+	// The print used to find the top of the chain goes to the device, the add does not
+	err = c.AddRule(&gotik.IPv4FilterRule{Chain: "input", Action: "accept", Protocol: "icmp", PlaceBeforePosition: "top"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.RemoveIPv4FilterRule("*5"); err != nil {
+		t.Fatal(err)
+	}
+
+	plan := c.ChangePlan()
+	want := []string{
+		"/ip/address/add =address=10.0.0.1/24 =interface=ether1 =disabled=false # *DRY1",
+		"/ip/firewall/filter/add =action=accept =chain=input =place-before=*A =protocol=icmp # *DRY2",
+		"/ip/firewall/filter/remove =.id=*5",
+	}
+	if len(plan) != len(want) {
+		t.Fatalf("len(ChangePlan())=%d; want %d", len(plan), len(want))
+	}
+	for i := range want {
+		if plan[i].String() != want[i] {
+			t.Errorf("#%d: %s; want %s", i, plan[i].String(), want[i])
+		}
+	}
+}
+
+func TestDryRunUnlistedVerbs(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+	// The server reads nothing: any command reaching it would fail
+	s.Close()
+
+	c.SetDryRun(true)
+	for _, sentence := range [][]string{
+		{"/password", "=old-password=a", "=new-password=b", "=confirm-new-password=b"},
+		{"/ip/dhcp-server/lease/make-static", "=numbers=*1"},
+		{"/certificate/sign", "=.id=*1"},
+	} {
+		if _, err := c.RunArgs(sentence); err != nil {
+			t.Fatalf("%#q: %v", sentence, err)
+		}
+	}
+	l, err := c.Listen("/ip/firewall/filter/reset-counters", "=numbers=*1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range l.Chan() {
+	}
+	if l.Done == nil {
+		t.Fatal("Listen() reply not done")
+	}
+
+	plan := c.ChangePlan()
+	if len(plan) != 4 {
+		t.Fatalf("len(ChangePlan())=%d; want 4: %v", len(plan), plan)
+	}
+	if got := plan[3].String(); got != "/ip/firewall/filter/reset-counters =numbers=*1" {
+		t.Errorf("Listen() change %s", got)
+	}
+}
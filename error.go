@@ -2,6 +2,8 @@ package gotik
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/jjcinaz/gotik/proto"
 )
@@ -32,4 +34,30 @@ func (err *DeviceError) Error() string {
 		m = "unknown error: " + err.Sentence.String()
 	}
 	return "from RouterOS device: " + m
-}
\ No newline at end of file
+}
+
+// ShutdownError records the outstanding commands which had not finished when Shutdown gave up waiting.
+// Each entry holds the tag and the command, for example "l3 /tool/torch".
+type ShutdownError struct {
+	Unfinished []string
+}
+
+func (err *ShutdownError) Error() string {
+	return "shutdown: commands did not finish: " + strings.Join(err.Unfinished, ", ")
+}
+
+// ImportError records a failure reported by /import.  Line and Column are zero if the device
+// did not say where in the script the error was.
+type ImportError struct {
+	File    string
+	Line    int
+	Column  int
+	Message string
+}
+
+func (err *ImportError) Error() string {
+	if err.Line > 0 {
+		return fmt.Sprintf("import %s: line %d: %s", err.File, err.Line, err.Message)
+	}
+	return "import " + err.File + ": " + err.Message
+}
@@ -3,8 +3,18 @@ package gotik
 import "errors"
 
 var (
-	ErrMissingId     = errors.New("missing ID")
-	ErrNotFound      = errors.New("not found")
-	ErrMissingChain  = errors.New("missing chain")
-	ErrVersionTooOld = errors.New("RouterOS version too old")
+	ErrMissingId       = errors.New("missing ID")
+	ErrNotFound        = errors.New("not found")
+	ErrMissingChain    = errors.New("missing chain")
+	ErrMissingProtocol = errors.New("ports need a protocol")
+	ErrVersionTooOld   = errors.New("RouterOS version too old")
+	ErrBatchAborted    = errors.New("batch aborted before command was sent")
+	ErrConnectionLost  = errors.New("connection lost: keepalive not answered")
+	ErrReadOnly        = errors.New("command rejected: client is read-only")
+	ErrTxActive        = errors.New("a transaction is already active")
+	ErrCannotRedial    = errors.New("client cannot reconnect: it was not created with a Dial function")
+	ErrTxDone          = errors.New("transaction has already been committed or rolled back")
+	ErrInvalidInterval = errors.New("interval must be positive")
+	ErrFileTruncated   = errors.New("file contents truncated")
+	ErrBinaryFile      = errors.New("binary files cannot be uploaded")
 )
@@ -1,9 +1,20 @@
 package gotik
 
 import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
 	"time"
 )
 
+const (
+	// DefaultFileChunkSize is the number of bytes requested with each /file/read when downloading.
+	DefaultFileChunkSize = 32768
+	// DefaultUploadChunkSize is the number of bytes sent with each /file/add when uploading.
+	DefaultUploadChunkSize = 16384
+)
+
 type File struct {
 	ID             string    `json:"id"`
 	Name           string    `json:"name"`
@@ -45,7 +56,7 @@ func (c *Client) GetAllFiles() ([]File, error) {
 
 func (c *Client) AddFile(name, contents string) error {
 	if c.majorVersion > 7 || (c.majorVersion == 7 && c.minorVersion >= 9) {
-		_, err := c.Run("/file/add", "=name="+name, "type=file", "=contents="+contents)
+		_, err := c.Run("/file/add", "=name="+name, "=type=file", "=contents="+contents)
 		if err != nil {
 			return err
 		}
@@ -54,6 +65,136 @@ func (c *Client) AddFile(name, contents string) error {
 	return ErrVersionTooOld
 }
 
+// UploadFile creates the file called name on the router with the contents read from r.  The file
+// must not already exist.  Contents larger than DefaultUploadChunkSize are sent as several part
+// files which a temporary script appends to the file and removes.  Once done, the size of the
+// file is checked against the number of bytes read.  If any step after the first part fails,
+// the file and any remaining parts are removed.  Requires RouterOS 7.9 or later.
+//
+// UploadFile is for text such as certificates, keys and scripts.  Packages, backups and other
+// binary files are out of scope: the contents pass through RouterOS script strings, so input
+// holding a NUL byte is rejected with ErrBinaryFile.  The size of the file is also limited to
+// the longest string a RouterOS script can hold, and each part rewrites the whole file, so the
+// time taken grows with the square of its size.  Upload larger files with FTP or SFTP.
+func (c *Client) UploadFile(name string, r io.Reader) error {
+	if c.majorVersion < 7 || (c.majorVersion == 7 && c.minorVersion < 9) {
+		return ErrVersionTooOld
+	}
+	var (
+		parts []string
+		size  int
+	)
+	// cleanup removes the file and the parts created so far
+	cleanup := func() {
+		c.removeFiles(append([]string{name}, parts...))
+	}
+	buf := make([]byte, DefaultUploadChunkSize)
+	for i := 0; ; i++ {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 || i == 0 {
+			part := name
+			if i > 0 {
+				part = fmt.Sprintf("%s.part%d", name, i)
+			}
+			if bytes.IndexByte(buf[:n], 0) >= 0 {
+				if i > 0 {
+					cleanup()
+				}
+				return ErrBinaryFile
+			}
+			if aerr := c.AddFile(part, string(buf[:n])); aerr != nil {
+				if i > 0 {
+					cleanup()
+				}
+				return aerr
+			}
+			if i > 0 {
+				parts = append(parts, part)
+			}
+			size += n
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			cleanup()
+			return err
+		}
+	}
+	if len(parts) > 0 {
+		if err := c.appendFiles(name, parts); err != nil {
+			cleanup()
+			return err
+		}
+	}
+	f, err := c.findFile(name)
+	if err != nil {
+		cleanup()
+		return err
+	}
+	if f.Size != size {
+		cleanup()
+		return fmt.Errorf("uploaded file %s is %d bytes; want %d", name, f.Size, size)
+	}
+	return nil
+}
+
+// appendFiles runs a temporary script which appends each of the parts to the file called
+// name, removing each part once it has been appended.
+func (c *Client) appendFiles(name string, parts []string) error {
+	quoted := make([]string, len(parts))
+	for i := range parts {
+		quoted[i] = quoteScriptString(parts[i])
+	}
+	source := fmt.Sprintf(":foreach p in={%s} do={\n"+
+		"  /file set [find name=%s] contents=([/file get [find name=%s] contents] . [/file get [find name=$p] contents])\n"+
+		"  /file remove [find name=$p]\n"+
+		"}\n", strings.Join(quoted, ";"), quoteScriptString(name), quoteScriptString(name))
+	id, err := c.AddScript(Script{
+		Name:   fmt.Sprintf("gotik-upload-%d", time.Now().UnixNano()),
+		Policy: []string{"read", "write", "ftp"},
+		Source: source,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = c.Run("/system/script/run", "=number="+id)
+	if rerr := c.RemoveScript(id); err == nil {
+		err = rerr
+	}
+	return err
+}
+
+// removeFiles removes files by name, ignoring errors, to clean up after a failure.
+func (c *Client) removeFiles(names []string) {
+	for _, name := range names {
+		_ = c.RemoveFileByName(name)
+	}
+}
+
+// quoteScriptString returns s as a double-quoted RouterOS script string.
+func quoteScriptString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"', '\\', '$':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
 func (c *Client) RemoveFileByName(name string) error {
 	_, err := c.Run("/file/remove", "=name="+name)
 	if err != nil {
@@ -69,3 +210,93 @@ func (c *Client) RemoveFileByID(id string) error {
 	}
 	return nil
 }
+
+// ReadFile returns the contents of the file called name on the router.
+func (c *Client) ReadFile(name string) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := c.DownloadFile(name, buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// DownloadFile copies the contents of the file called name on the router to w.
+// On RouterOS 7.13 and later, the file is read in chunks of DefaultFileChunkSize bytes with
+// /file/read.  Older versions can only return the contents from /file/print, which RouterOS
+// limits to files of about 4KB.  Either way, if fewer bytes arrive than the size the router
+// prints for the file, an error wrapping ErrFileTruncated is returned after copying them.
+func (c *Client) DownloadFile(name string, w io.Writer) error {
+	chunked := c.majorVersion > 7 || (c.majorVersion == 7 && c.minorVersion >= 13)
+	proplist := "=.proplist=size,contents"
+	if chunked {
+		proplist = "=.proplist=size"
+	}
+	detail, err := c.Run("/file/print", "?name="+name, proplist)
+	if err != nil {
+		return err
+	}
+	if len(detail.Re) == 0 {
+		return ErrNotFound
+	}
+	size := parseInt(detail.Re[0].Map["size"])
+	if chunked {
+		return c.downloadFileChunked(name, size, w)
+	}
+	contents := detail.Re[0].Map["contents"]
+	if _, err = io.WriteString(w, contents); err != nil {
+		return err
+	}
+	return checkFileSize(name, len(contents), size)
+}
+
+// downloadFileChunked reads the file with /file/read until size bytes or an empty chunk
+// have been received.
+func (c *Client) downloadFileChunked(name string, size int, w io.Writer) error {
+	offset := 0
+	for offset < size {
+		detail, err := c.Run("/file/read", "=file="+name, fmt.Sprintf("=offset=%d", offset),
+			fmt.Sprintf("=chunk-size=%d", DefaultFileChunkSize))
+		if err != nil {
+			return err
+		}
+		var data string
+		if len(detail.Re) > 0 {
+			data = detail.Re[0].Map["data"]
+		} else if detail.Done != nil {
+			data = detail.Done.Map["data"]
+		}
+		if len(data) == 0 {
+			break
+		}
+		if _, err = io.WriteString(w, data); err != nil {
+			return err
+		}
+		offset += len(data)
+	}
+	return checkFileSize(name, offset, size)
+}
+
+func checkFileSize(name string, got, size int) error {
+	if got < size {
+		return fmt.Errorf("%s: %w: received %d of %d bytes", name, ErrFileTruncated, got, size)
+	}
+	return nil
+}
+
+// ExportConfigToWriter exports the configuration from base, as ExportConfig does, and copies
+// the export to w.  The temporary file on the router is removed afterward.
+func (c *Client) ExportConfigToWriter(base string, w io.Writer, hideSensitive bool) error {
+	name := fmt.Sprintf("gotik-export-%d", time.Now().UnixNano())
+	if err := c.ExportConfig(base, name, hideSensitive); err != nil {
+		return err
+	}
+	f, err := c.findFile(name + ".rsc")
+	if err != nil {
+		return err
+	}
+	err = c.DownloadFile(f.Name, w)
+	if rerr := c.RemoveFileByID(f.ID); err == nil {
+		err = rerr
+	}
+	return err
+}
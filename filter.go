@@ -20,6 +20,7 @@ func (c *Client) GetIPv4Filters(chain string) ([]IPv4FilterRule, error) {
 	for _, re := range detail.Re {
 		var entry IPv4FilterRule
 		parseTikObject(re.Map, &entry)
+		parseRuleCounters(re.Map, &entry)
 		// Correct for bug in certain ROS versions where "action=accept" is returned as an empty action
 		if len(entry.Action) == 0 {
 			entry.Action = "accept"
@@ -75,8 +76,8 @@ func (r *IPv4FilterRule) String() string {
 	if len(r.SrcAddress) > 0 {
 		a = append(a, fmt.Sprintf("src-address=%s", r.SrcAddress))
 	}
-	if len(r.SrcPort) > 0 {
-		a = append(a, fmt.Sprintf("src-port=%s", r.SrcPort))
+	if !r.SrcPort.IsZero() {
+		a = append(a, "src-port="+r.SrcPort.String())
 	}
 	if len(r.InInterface) > 0 {
 		a = append(a, fmt.Sprintf("in-interface=%s", r.InInterface))
@@ -90,8 +91,8 @@ func (r *IPv4FilterRule) String() string {
 	if len(r.DstAddress) > 0 {
 		a = append(a, fmt.Sprintf("dst-address=%s", r.DstAddress))
 	}
-	if len(r.DstPort) > 0 {
-		a = append(a, fmt.Sprintf("dst-port=%s", r.DstPort))
+	if !r.DstPort.IsZero() {
+		a = append(a, "dst-port="+r.DstPort.String())
 	}
 	if r.Action == "jump" {
 		a = append(a, fmt.Sprintf("action=%s", r.Action), fmt.Sprintf("jump-target=%s", r.JumpTarget))
@@ -110,6 +111,13 @@ func (r *IPv4FilterRule) String() string {
 }
 
 func IPv4RuleCompareEq(a, b IPv4FilterRule, propsToIgnore []string) bool {
+	return RuleCompareEq(a, b, propsToIgnore)
+}
+
+// RuleCompareEq compares two firewall rules of the same type (IPv4FilterRule, IPv6FilterRule,
+// IPv6MangleRule, etc.) field by field, ignoring the ID, the position and the fields named in
+// propsToIgnore.
+func RuleCompareEq[T any](a, b T, propsToIgnore []string) bool {
 	ignoreMap := make(map[string]struct{}, len(propsToIgnore))
 	for _, prop := range propsToIgnore {
 		ignoreMap[prop] = struct{}{}
@@ -118,6 +126,8 @@ func IPv4RuleCompareEq(a, b IPv4FilterRule, propsToIgnore []string) bool {
 	ignoreMap["ID"] = struct{}{}
 	ignoreMap["RouterLocation"] = struct{}{}
 	ignoreMap["PlaceBeforePosition"] = struct{}{}
+	ignoreMap["Bytes"] = struct{}{}
+	ignoreMap["Packets"] = struct{}{}
 
 	va := reflect.ValueOf(a)
 	vb := reflect.ValueOf(b)
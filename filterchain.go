@@ -0,0 +1,110 @@
+package gotik
+
+import (
+	"fmt"
+
+	"github.com/jjcinaz/gotik/internal/order"
+)
+
+// ChainChange is one change made by ApplyFilterChain.
+type ChainChange struct {
+	Operation rune   // A=Add, D=Delete, M=Move
+	ID        string // ID of the rule; for an add, the ID it was given
+	Before    string // ID of the rule it was placed before, empty for the end of the list
+	Rule      IPv4FilterRule
+}
+
+func (e *ChainChange) String() string {
+	s := fmt.Sprintf("%c: %s %s", e.Operation, e.ID, e.Rule.String())
+	if len(e.Before) > 0 {
+		s += " before " + e.Before
+	}
+	return s
+}
+
+// chainCompareIgnore are the fields of IPv4FilterRule which ApplyFilterChain does not compare.
+var chainCompareIgnore = []string{"Dynamic", "Invalid", "PlaceBefore"}
+
+// ApplyFilterChain makes the rules of chain in the IPv4 filter match desired, in order.
+// Rules are compared with IPv4RuleCompareEq.  Rules which are in the chain but not desired
+// are removed, desired rules which are missing are added in place, and rules which are out
+// of order are moved with /ip/firewall/filter/move.  The largest set of rules already in the
+// right relative order is left alone, so the number of moves is as small as possible.
+// Dynamic rules are left untouched.  The changes made are returned.
+func (c *Client) ApplyFilterChain(chain string, desired []IPv4FilterRule) ([]ChainChange, error) {
+	if len(chain) == 0 {
+		return nil, ErrMissingChain
+	}
+	current, err := c.GetIPv4Filters(chain)
+	if err != nil {
+		return nil, err
+	}
+	want := make([]IPv4FilterRule, len(desired))
+	for i, r := range desired {
+		r.ID, r.PlaceBefore, r.PlaceBeforePosition = "", "", ""
+		if len(r.Chain) == 0 {
+			r.Chain = chain
+		}
+		if len(r.Action) == 0 {
+			r.Action = "accept"
+		}
+		want[i] = r
+	}
+
+	// match each desired rule to the first equal rule not already matched
+	static := make([]IPv4FilterRule, 0, len(current))
+	for _, r := range current {
+		if !r.Dynamic {
+			static = append(static, r)
+		}
+	}
+	match := make([]int, len(want)) // index in static of the match of each desired rule, or -1
+	taken := make([]bool, len(static))
+	for i := range want {
+		match[i] = -1
+		for j := range static {
+			if !taken[j] && IPv4RuleCompareEq(want[i], static[j], chainCompareIgnore) {
+				match[i], taken[j] = j, true
+				break
+			}
+		}
+	}
+	keep := order.LongestIncreasing(match)
+
+	var changes []ChainChange
+	for j, r := range static {
+		if taken[j] {
+			continue
+		}
+		if err = c.RemoveIPv4FilterRule(r.ID); err != nil {
+			return changes, err
+		}
+		changes = append(changes, ChainChange{Operation: 'D', ID: r.ID, Rule: r})
+	}
+	// place rules from the last to the first, each one before the rule which follows it
+	next := ""
+	for i := len(want) - 1; i >= 0; i-- {
+		switch {
+		case match[i] < 0:
+			r := want[i]
+			r.PlaceBefore = next
+			reply, err := c.Run(GenerateTikSentence("/ip/firewall/filter/add", "=", true, &r)...)
+			if err != nil {
+				return changes, err
+			}
+			r.ID = reply.Done.Map["ret"]
+			changes = append(changes, ChainChange{Operation: 'A', ID: r.ID, Before: next, Rule: r})
+			next = r.ID
+		case !keep[i]:
+			r := static[match[i]]
+			if err = c.moveIDs("/ip/firewall/filter", []string{r.ID}, next); err != nil {
+				return changes, err
+			}
+			changes = append(changes, ChainChange{Operation: 'M', ID: r.ID, Before: next, Rule: r})
+			next = r.ID
+		default:
+			next = static[match[i]].ID
+		}
+	}
+	return changes, nil
+}
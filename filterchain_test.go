@@ -0,0 +1,49 @@
+package gotik_test
+
+import (
+	"bufio"
+	"testing"
+
+	"github.com/jjcinaz/gotik"
+)
+
+func TestApplyFilterChain(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+	r := bufio.NewReader(s.Closer.(*conn).PipeReader)
+
+	go func() {
+		defer s.Close()
+		readWords(t, r, "/ip/firewall/filter/print ?=chain=input")
+		s.writeSentence(t, "!re", "=.id=*1", "=chain=input", "=action=accept", "=comment=A")
+		s.writeSentence(t, "!re", "=.id=*2", "=chain=input", "=action=accept", "=comment=B")
+		s.writeSentence(t, "!re", "=.id=*3", "=chain=input", "=action=accept", "=comment=X")
+		s.writeSentence(t, "!re", "=.id=*4", "=chain=input", "=action=accept", "=comment=dyn", "=dynamic=true")
+		s.writeSentence(t, "!re", "=.id=*5", "=chain=input", "=action=accept", "=comment=C")
+		s.writeSentence(t, "!done")
+		readWords(t, r, "/ip/firewall/filter/remove =.id=*3")
+		s.writeSentence(t, "!done")
+		readWords(t, r, "/ip/firewall/filter/add =action=drop =chain=input =comment=N")
+		s.writeSentence(t, "!done", "=ret=*6")
+		readWords(t, r, "/ip/firewall/filter/move =numbers=*5 =destination=*1")
+		s.writeSentence(t, "!done")
+	}()
+
+	desired := []gotik.IPv4FilterRule{
+		{Action: "accept", Comment: "C"},
+		{Action: "accept", Comment: "A"},
+		{Action: "accept", Comment: "B"},
+		{Action: "drop", Comment: "N"},
+	}
+	changes, err := c.ApplyFilterChain("input", desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ops := ""
+	for _, e := range changes {
+		ops += string(e.Operation)
+	}
+	if ops != "DAM" {
+		t.Fatalf("operations %s; want DAM", ops)
+	}
+}
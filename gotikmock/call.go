@@ -0,0 +1,17 @@
+// Package gotikmock provides mock implementations of the gotik interfaces (RouterOS,
+// FirewallAPI, SystemAPI, ...) for unit testing code which uses a *gotik.Client.
+// Set the Func field for each method the code under test is expected to call:
+//
+//	m := &gotikmock.RouterOSMock{
+//		GetSystemIdFunc: func() (string, error) { return "router1", nil },
+//	}
+//	provision(m)
+//
+// The mocks are generated from api.go by running go generate in the gotik package.
+package gotikmock
+
+// Call records one call made on a mock.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
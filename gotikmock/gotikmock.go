@@ -0,0 +1,5282 @@
+// Code generated by internal/mockgen from api.go; DO NOT EDIT.
+
+package gotikmock
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/jjcinaz/gotik"
+)
+
+var _ gotik.CommandAPI = (*CommandAPIMock)(nil)
+var _ gotik.FirewallAPI = (*FirewallAPIMock)(nil)
+var _ gotik.InterfaceAPI = (*InterfaceAPIMock)(nil)
+var _ gotik.IPAPI = (*IPAPIMock)(nil)
+var _ gotik.PPPAPI = (*PPPAPIMock)(nil)
+var _ gotik.QueueAPI = (*QueueAPIMock)(nil)
+var _ gotik.RoutingAPI = (*RoutingAPIMock)(nil)
+var _ gotik.SystemAPI = (*SystemAPIMock)(nil)
+var _ gotik.RouterOS = (*RouterOSMock)(nil)
+
+// CommandAPIMock is a mock implementation of gotik.CommandAPI.
+type CommandAPIMock struct {
+	// AllowInsecureCleartextFunc mocks the AllowInsecureCleartext method.
+	AllowInsecureCleartextFunc func(value bool)
+
+	// LoginFunc mocks the Login method.
+	LoginFunc func(username string, password string) error
+
+	// CloseFunc mocks the Close method.
+	CloseFunc func()
+
+	// ShutdownFunc mocks the Shutdown method.
+	ShutdownFunc func(ctx context.Context) error
+
+	// CurrentAddressFunc mocks the CurrentAddress method.
+	CurrentAddressFunc func() string
+
+	// CurrentVersionFunc mocks the CurrentVersion method.
+	CurrentVersionFunc func() (string, int, int, int)
+
+	// AsyncFunc mocks the Async method.
+	AsyncFunc func() <-chan error
+
+	// RunFunc mocks the Run method.
+	RunFunc func(sentence ...string) (*gotik.Reply, error)
+
+	// RunArgsFunc mocks the RunArgs method.
+	RunArgsFunc func(sentence []string) (*gotik.Reply, error)
+
+	// RunCmdFunc mocks the RunCmd method.
+	RunCmdFunc func(cmd string, sentence ...string) (*gotik.Reply, error)
+
+	// RunAsyncFunc mocks the RunAsync method.
+	RunAsyncFunc func(sentence ...string) *gotik.Pending
+
+	// RunArgsAsyncFunc mocks the RunArgsAsync method.
+	RunArgsAsyncFunc func(sentence []string) *gotik.Pending
+
+	// BatchFunc mocks the Batch method.
+	BatchFunc func() *gotik.Batch
+
+	// ListenFunc mocks the Listen method.
+	ListenFunc func(sentence ...string) (*gotik.ListenReply, error)
+
+	// ListenArgsFunc mocks the ListenArgs method.
+	ListenArgsFunc func(sentence []string) (*gotik.ListenReply, error)
+
+	// ListenArgsQueueFunc mocks the ListenArgsQueue method.
+	ListenArgsQueueFunc func(sentence []string, queueSize int) (*gotik.ListenReply, error)
+
+	// SetDryRunFunc mocks the SetDryRun method.
+	SetDryRunFunc func(enabled bool)
+
+	// DryRunFunc mocks the DryRun method.
+	DryRunFunc func() bool
+
+	// ChangePlanFunc mocks the ChangePlan method.
+	ChangePlanFunc func() []gotik.PlannedChange
+
+	// ResetChangePlanFunc mocks the ResetChangePlan method.
+	ResetChangePlanFunc func()
+
+	// SetReadOnlyFunc mocks the SetReadOnly method.
+	SetReadOnlyFunc func(enabled bool)
+
+	// ReadOnlyFunc mocks the ReadOnly method.
+	ReadOnlyFunc func() bool
+
+	// SetJournalFunc mocks the SetJournal method.
+	SetJournalFunc func(j *gotik.Journal) error
+
+	// BeginTxFunc mocks the BeginTx method.
+	BeginTxFunc func() (*gotik.Tx, error)
+
+	// WithTxFunc mocks the WithTx method.
+	WithTxFunc func(fn func() error) error
+
+	// CommitConfirmFunc mocks the CommitConfirm method.
+	CommitConfirmFunc func(timeout time.Duration, changes func() error) error
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made on the mock so far, in order.
+func (m *CommandAPIMock) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *CommandAPIMock) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+	m.mu.Unlock()
+}
+
+// AllowInsecureCleartext calls AllowInsecureCleartextFunc.
+func (m *CommandAPIMock) AllowInsecureCleartext(value bool) {
+	m.record("AllowInsecureCleartext", value)
+	if m.AllowInsecureCleartextFunc == nil {
+		panic("CommandAPIMock.AllowInsecureCleartextFunc: method is nil but AllowInsecureCleartext was just called")
+	}
+	m.AllowInsecureCleartextFunc(value)
+}
+
+// Login calls LoginFunc.
+func (m *CommandAPIMock) Login(username string, password string) error {
+	m.record("Login", username, password)
+	if m.LoginFunc == nil {
+		panic("CommandAPIMock.LoginFunc: method is nil but Login was just called")
+	}
+	return m.LoginFunc(username, password)
+}
+
+// Close calls CloseFunc.
+func (m *CommandAPIMock) Close() {
+	m.record("Close")
+	if m.CloseFunc == nil {
+		panic("CommandAPIMock.CloseFunc: method is nil but Close was just called")
+	}
+	m.CloseFunc()
+}
+
+// Shutdown calls ShutdownFunc.
+func (m *CommandAPIMock) Shutdown(ctx context.Context) error {
+	m.record("Shutdown", ctx)
+	if m.ShutdownFunc == nil {
+		panic("CommandAPIMock.ShutdownFunc: method is nil but Shutdown was just called")
+	}
+	return m.ShutdownFunc(ctx)
+}
+
+// CurrentAddress calls CurrentAddressFunc.
+func (m *CommandAPIMock) CurrentAddress() string {
+	m.record("CurrentAddress")
+	if m.CurrentAddressFunc == nil {
+		panic("CommandAPIMock.CurrentAddressFunc: method is nil but CurrentAddress was just called")
+	}
+	return m.CurrentAddressFunc()
+}
+
+// CurrentVersion calls CurrentVersionFunc.
+func (m *CommandAPIMock) CurrentVersion() (string, int, int, int) {
+	m.record("CurrentVersion")
+	if m.CurrentVersionFunc == nil {
+		panic("CommandAPIMock.CurrentVersionFunc: method is nil but CurrentVersion was just called")
+	}
+	return m.CurrentVersionFunc()
+}
+
+// Async calls AsyncFunc.
+func (m *CommandAPIMock) Async() <-chan error {
+	m.record("Async")
+	if m.AsyncFunc == nil {
+		panic("CommandAPIMock.AsyncFunc: method is nil but Async was just called")
+	}
+	return m.AsyncFunc()
+}
+
+// Run calls RunFunc.
+func (m *CommandAPIMock) Run(sentence ...string) (*gotik.Reply, error) {
+	m.record("Run", sentence)
+	if m.RunFunc == nil {
+		panic("CommandAPIMock.RunFunc: method is nil but Run was just called")
+	}
+	return m.RunFunc(sentence...)
+}
+
+// RunArgs calls RunArgsFunc.
+func (m *CommandAPIMock) RunArgs(sentence []string) (*gotik.Reply, error) {
+	m.record("RunArgs", sentence)
+	if m.RunArgsFunc == nil {
+		panic("CommandAPIMock.RunArgsFunc: method is nil but RunArgs was just called")
+	}
+	return m.RunArgsFunc(sentence)
+}
+
+// RunCmd calls RunCmdFunc.
+func (m *CommandAPIMock) RunCmd(cmd string, sentence ...string) (*gotik.Reply, error) {
+	m.record("RunCmd", cmd, sentence)
+	if m.RunCmdFunc == nil {
+		panic("CommandAPIMock.RunCmdFunc: method is nil but RunCmd was just called")
+	}
+	return m.RunCmdFunc(cmd, sentence...)
+}
+
+// RunAsync calls RunAsyncFunc.
+func (m *CommandAPIMock) RunAsync(sentence ...string) *gotik.Pending {
+	m.record("RunAsync", sentence)
+	if m.RunAsyncFunc == nil {
+		panic("CommandAPIMock.RunAsyncFunc: method is nil but RunAsync was just called")
+	}
+	return m.RunAsyncFunc(sentence...)
+}
+
+// RunArgsAsync calls RunArgsAsyncFunc.
+func (m *CommandAPIMock) RunArgsAsync(sentence []string) *gotik.Pending {
+	m.record("RunArgsAsync", sentence)
+	if m.RunArgsAsyncFunc == nil {
+		panic("CommandAPIMock.RunArgsAsyncFunc: method is nil but RunArgsAsync was just called")
+	}
+	return m.RunArgsAsyncFunc(sentence)
+}
+
+// Batch calls BatchFunc.
+func (m *CommandAPIMock) Batch() *gotik.Batch {
+	m.record("Batch")
+	if m.BatchFunc == nil {
+		panic("CommandAPIMock.BatchFunc: method is nil but Batch was just called")
+	}
+	return m.BatchFunc()
+}
+
+// Listen calls ListenFunc.
+func (m *CommandAPIMock) Listen(sentence ...string) (*gotik.ListenReply, error) {
+	m.record("Listen", sentence)
+	if m.ListenFunc == nil {
+		panic("CommandAPIMock.ListenFunc: method is nil but Listen was just called")
+	}
+	return m.ListenFunc(sentence...)
+}
+
+// ListenArgs calls ListenArgsFunc.
+func (m *CommandAPIMock) ListenArgs(sentence []string) (*gotik.ListenReply, error) {
+	m.record("ListenArgs", sentence)
+	if m.ListenArgsFunc == nil {
+		panic("CommandAPIMock.ListenArgsFunc: method is nil but ListenArgs was just called")
+	}
+	return m.ListenArgsFunc(sentence)
+}
+
+// ListenArgsQueue calls ListenArgsQueueFunc.
+func (m *CommandAPIMock) ListenArgsQueue(sentence []string, queueSize int) (*gotik.ListenReply, error) {
+	m.record("ListenArgsQueue", sentence, queueSize)
+	if m.ListenArgsQueueFunc == nil {
+		panic("CommandAPIMock.ListenArgsQueueFunc: method is nil but ListenArgsQueue was just called")
+	}
+	return m.ListenArgsQueueFunc(sentence, queueSize)
+}
+
+// SetDryRun calls SetDryRunFunc.
+func (m *CommandAPIMock) SetDryRun(enabled bool) {
+	m.record("SetDryRun", enabled)
+	if m.SetDryRunFunc == nil {
+		panic("CommandAPIMock.SetDryRunFunc: method is nil but SetDryRun was just called")
+	}
+	m.SetDryRunFunc(enabled)
+}
+
+// DryRun calls DryRunFunc.
+func (m *CommandAPIMock) DryRun() bool {
+	m.record("DryRun")
+	if m.DryRunFunc == nil {
+		panic("CommandAPIMock.DryRunFunc: method is nil but DryRun was just called")
+	}
+	return m.DryRunFunc()
+}
+
+// ChangePlan calls ChangePlanFunc.
+func (m *CommandAPIMock) ChangePlan() []gotik.PlannedChange {
+	m.record("ChangePlan")
+	if m.ChangePlanFunc == nil {
+		panic("CommandAPIMock.ChangePlanFunc: method is nil but ChangePlan was just called")
+	}
+	return m.ChangePlanFunc()
+}
+
+// ResetChangePlan calls ResetChangePlanFunc.
+func (m *CommandAPIMock) ResetChangePlan() {
+	m.record("ResetChangePlan")
+	if m.ResetChangePlanFunc == nil {
+		panic("CommandAPIMock.ResetChangePlanFunc: method is nil but ResetChangePlan was just called")
+	}
+	m.ResetChangePlanFunc()
+}
+
+// SetReadOnly calls SetReadOnlyFunc.
+func (m *CommandAPIMock) SetReadOnly(enabled bool) {
+	m.record("SetReadOnly", enabled)
+	if m.SetReadOnlyFunc == nil {
+		panic("CommandAPIMock.SetReadOnlyFunc: method is nil but SetReadOnly was just called")
+	}
+	m.SetReadOnlyFunc(enabled)
+}
+
+// ReadOnly calls ReadOnlyFunc.
+func (m *CommandAPIMock) ReadOnly() bool {
+	m.record("ReadOnly")
+	if m.ReadOnlyFunc == nil {
+		panic("CommandAPIMock.ReadOnlyFunc: method is nil but ReadOnly was just called")
+	}
+	return m.ReadOnlyFunc()
+}
+
+// SetJournal calls SetJournalFunc.
+func (m *CommandAPIMock) SetJournal(j *gotik.Journal) error {
+	m.record("SetJournal", j)
+	if m.SetJournalFunc == nil {
+		panic("CommandAPIMock.SetJournalFunc: method is nil but SetJournal was just called")
+	}
+	return m.SetJournalFunc(j)
+}
+
+// BeginTx calls BeginTxFunc.
+func (m *CommandAPIMock) BeginTx() (*gotik.Tx, error) {
+	m.record("BeginTx")
+	if m.BeginTxFunc == nil {
+		panic("CommandAPIMock.BeginTxFunc: method is nil but BeginTx was just called")
+	}
+	return m.BeginTxFunc()
+}
+
+// WithTx calls WithTxFunc.
+func (m *CommandAPIMock) WithTx(fn func() error) error {
+	m.record("WithTx", fn)
+	if m.WithTxFunc == nil {
+		panic("CommandAPIMock.WithTxFunc: method is nil but WithTx was just called")
+	}
+	return m.WithTxFunc(fn)
+}
+
+// CommitConfirm calls CommitConfirmFunc.
+func (m *CommandAPIMock) CommitConfirm(timeout time.Duration, changes func() error) error {
+	m.record("CommitConfirm", timeout, changes)
+	if m.CommitConfirmFunc == nil {
+		panic("CommandAPIMock.CommitConfirmFunc: method is nil but CommitConfirm was just called")
+	}
+	return m.CommitConfirmFunc(timeout, changes)
+}
+
+// FirewallAPIMock is a mock implementation of gotik.FirewallAPI.
+type FirewallAPIMock struct {
+	// GetIPv4FiltersFunc mocks the GetIPv4Filters method.
+	GetIPv4FiltersFunc func(chain string) ([]gotik.IPv4FilterRule, error)
+
+	// RemoveIPv4FilterRuleFunc mocks the RemoveIPv4FilterRule method.
+	RemoveIPv4FilterRuleFunc func(id string) error
+
+	// EnableIPv4FilterRuleFunc mocks the EnableIPv4FilterRule method.
+	EnableIPv4FilterRuleFunc func(id string) error
+
+	// DisableIPv4FilterRuleFunc mocks the DisableIPv4FilterRule method.
+	DisableIPv4FilterRuleFunc func(id string) error
+
+	// ResetFilterCountersFunc mocks the ResetFilterCounters method.
+	ResetFilterCountersFunc func(chain string) error
+
+	// ResetCountersFunc mocks the ResetCounters method.
+	ResetCountersFunc func(id string) error
+
+	// SampleFilterRatesFunc mocks the SampleFilterRates method.
+	SampleFilterRatesFunc func(ctx context.Context, chain string, interval time.Duration) (<-chan gotik.FilterSample, error)
+
+	// ApplyFilterChainFunc mocks the ApplyFilterChain method.
+	ApplyFilterChainFunc func(chain string, desired []gotik.IPv4FilterRule) ([]gotik.ChainChange, error)
+
+	// GetIPv4NatFunc mocks the GetIPv4Nat method.
+	GetIPv4NatFunc func(chain string) ([]gotik.IPv4NatRule, error)
+
+	// RemoveIPv4NatRuleFunc mocks the RemoveIPv4NatRule method.
+	RemoveIPv4NatRuleFunc func(id string) error
+
+	// AddPortForwardFunc mocks the AddPortForward method.
+	AddPortForwardFunc func(inInterface string, protocol string, dstPort gotik.PortSet, toAddress string, toPorts gotik.PortSet, comment string) error
+
+	// AddMasqueradeFunc mocks the AddMasquerade method.
+	AddMasqueradeFunc func(outInterface string, comment string) error
+
+	// AddSrcNATFunc mocks the AddSrcNAT method.
+	AddSrcNATFunc func(srcAddress string, outInterface string, toAddress string, comment string) error
+
+	// GetIPv4MangleFunc mocks the GetIPv4Mangle method.
+	GetIPv4MangleFunc func(chain string) ([]gotik.IPv4MangleRule, error)
+
+	// AddIPv4MangleRuleFunc mocks the AddIPv4MangleRule method.
+	AddIPv4MangleRuleFunc func(rule gotik.IPv4MangleRule) (string, error)
+
+	// RemoveIPv4MangleRuleFunc mocks the RemoveIPv4MangleRule method.
+	RemoveIPv4MangleRuleFunc func(id string) error
+
+	// EnableIPv4MangleRuleFunc mocks the EnableIPv4MangleRule method.
+	EnableIPv4MangleRuleFunc func(id string) error
+
+	// DisableIPv4MangleRuleFunc mocks the DisableIPv4MangleRule method.
+	DisableIPv4MangleRuleFunc func(id string) error
+
+	// GetIPv4RawFunc mocks the GetIPv4Raw method.
+	GetIPv4RawFunc func(chain string) ([]gotik.IPv4RawRule, error)
+
+	// AddIPv4RawRuleFunc mocks the AddIPv4RawRule method.
+	AddIPv4RawRuleFunc func(rule gotik.IPv4RawRule) (string, error)
+
+	// RemoveIPv4RawRuleFunc mocks the RemoveIPv4RawRule method.
+	RemoveIPv4RawRuleFunc func(id string) error
+
+	// EnableIPv4RawRuleFunc mocks the EnableIPv4RawRule method.
+	EnableIPv4RawRuleFunc func(id string) error
+
+	// DisableIPv4RawRuleFunc mocks the DisableIPv4RawRule method.
+	DisableIPv4RawRuleFunc func(id string) error
+
+	// GetIPv6FiltersFunc mocks the GetIPv6Filters method.
+	GetIPv6FiltersFunc func(chain string) ([]gotik.IPv6FilterRule, error)
+
+	// AddIPv6FilterRuleFunc mocks the AddIPv6FilterRule method.
+	AddIPv6FilterRuleFunc func(rule gotik.IPv6FilterRule) (string, error)
+
+	// RemoveIPv6FilterRuleFunc mocks the RemoveIPv6FilterRule method.
+	RemoveIPv6FilterRuleFunc func(id string) error
+
+	// EnableIPv6FilterRuleFunc mocks the EnableIPv6FilterRule method.
+	EnableIPv6FilterRuleFunc func(id string) error
+
+	// DisableIPv6FilterRuleFunc mocks the DisableIPv6FilterRule method.
+	DisableIPv6FilterRuleFunc func(id string) error
+
+	// GetIPv6NatFunc mocks the GetIPv6Nat method.
+	GetIPv6NatFunc func(chain string) ([]gotik.IPv6NatRule, error)
+
+	// AddIPv6NatRuleFunc mocks the AddIPv6NatRule method.
+	AddIPv6NatRuleFunc func(rule gotik.IPv6NatRule) (string, error)
+
+	// RemoveIPv6NatRuleFunc mocks the RemoveIPv6NatRule method.
+	RemoveIPv6NatRuleFunc func(id string) error
+
+	// EnableIPv6NatRuleFunc mocks the EnableIPv6NatRule method.
+	EnableIPv6NatRuleFunc func(id string) error
+
+	// DisableIPv6NatRuleFunc mocks the DisableIPv6NatRule method.
+	DisableIPv6NatRuleFunc func(id string) error
+
+	// GetIPv6MangleFunc mocks the GetIPv6Mangle method.
+	GetIPv6MangleFunc func(chain string) ([]gotik.IPv6MangleRule, error)
+
+	// AddIPv6MangleRuleFunc mocks the AddIPv6MangleRule method.
+	AddIPv6MangleRuleFunc func(rule gotik.IPv6MangleRule) (string, error)
+
+	// RemoveIPv6MangleRuleFunc mocks the RemoveIPv6MangleRule method.
+	RemoveIPv6MangleRuleFunc func(id string) error
+
+	// EnableIPv6MangleRuleFunc mocks the EnableIPv6MangleRule method.
+	EnableIPv6MangleRuleFunc func(id string) error
+
+	// DisableIPv6MangleRuleFunc mocks the DisableIPv6MangleRule method.
+	DisableIPv6MangleRuleFunc func(id string) error
+
+	// GetIPv6RawFunc mocks the GetIPv6Raw method.
+	GetIPv6RawFunc func(chain string) ([]gotik.IPv6RawRule, error)
+
+	// AddIPv6RawRuleFunc mocks the AddIPv6RawRule method.
+	AddIPv6RawRuleFunc func(rule gotik.IPv6RawRule) (string, error)
+
+	// RemoveIPv6RawRuleFunc mocks the RemoveIPv6RawRule method.
+	RemoveIPv6RawRuleFunc func(id string) error
+
+	// EnableIPv6RawRuleFunc mocks the EnableIPv6RawRule method.
+	EnableIPv6RawRuleFunc func(id string) error
+
+	// DisableIPv6RawRuleFunc mocks the DisableIPv6RawRule method.
+	DisableIPv6RawRuleFunc func(id string) error
+
+	// GetIDSFunc mocks the GetIDS method.
+	GetIDSFunc func(in interface{}) ([]string, string, error)
+
+	// CommitRuleFunc mocks the CommitRule method.
+	CommitRuleFunc func(in interface{}) error
+
+	// AddRuleFunc mocks the AddRule method.
+	AddRuleFunc func(in interface{}) error
+
+	// RemoveRuleFunc mocks the RemoveRule method.
+	RemoveRuleFunc func(in interface{}) error
+
+	// RemoveRuleByIDFunc mocks the RemoveRuleByID method.
+	RemoveRuleByIDFunc func(in interface{}) error
+
+	// ModifyRuleFunc mocks the ModifyRule method.
+	ModifyRuleFunc func(in interface{}, action string) error
+
+	// MoveRuleFunc mocks the MoveRule method.
+	MoveRuleFunc func(in interface{}, before string) error
+
+	// RuleIsDisabledFunc mocks the RuleIsDisabled method.
+	RuleIsDisabledFunc func(in interface{}) (bool, error)
+
+	// AddObjectFunc mocks the AddObject method.
+	AddObjectFunc func(in interface{}) error
+
+	// GetIPv4AddressListFunc mocks the GetIPv4AddressList method.
+	GetIPv4AddressListFunc func(listname string) ([]gotik.AddressList, error)
+
+	// GetIPv6AddressListFunc mocks the GetIPv6AddressList method.
+	GetIPv6AddressListFunc func(listname string) ([]gotik.AddressList, error)
+
+	// AuditIPv4AddressListFunc mocks the AuditIPv4AddressList method.
+	AuditIPv4AddressListFunc func(listname string, list []gotik.AddressList, goodList map[string]string, applyAudits bool) ([]gotik.AddressListAudit, error)
+
+	// AuditIPv6AddressListFunc mocks the AuditIPv6AddressList method.
+	AuditIPv6AddressListFunc func(listname string, list []gotik.AddressList, goodList map[string]string, applyAudits bool) ([]gotik.AddressListAudit, error)
+
+	// GetConnectionsFunc mocks the GetConnections method.
+	GetConnectionsFunc func(f gotik.ConnectionFilter) ([]gotik.Connection, error)
+
+	// ListenConnectionsFunc mocks the ListenConnections method.
+	ListenConnectionsFunc func(f gotik.ConnectionFilter, fn func(arg0 gotik.Connection) error) error
+
+	// RemoveConnectionsFunc mocks the RemoveConnections method.
+	RemoveConnectionsFunc func(f gotik.ConnectionFilter) (int, error)
+
+	// GetConnectionTrackingFunc mocks the GetConnectionTracking method.
+	GetConnectionTrackingFunc func() (gotik.ConnectionTracking, error)
+
+	// SetConnectionTrackingFunc mocks the SetConnectionTracking method.
+	SetConnectionTrackingFunc func(settings gotik.ConnectionTracking) error
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made on the mock so far, in order.
+func (m *FirewallAPIMock) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *FirewallAPIMock) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+	m.mu.Unlock()
+}
+
+// GetIPv4Filters calls GetIPv4FiltersFunc.
+func (m *FirewallAPIMock) GetIPv4Filters(chain string) ([]gotik.IPv4FilterRule, error) {
+	m.record("GetIPv4Filters", chain)
+	if m.GetIPv4FiltersFunc == nil {
+		panic("FirewallAPIMock.GetIPv4FiltersFunc: method is nil but GetIPv4Filters was just called")
+	}
+	return m.GetIPv4FiltersFunc(chain)
+}
+
+// RemoveIPv4FilterRule calls RemoveIPv4FilterRuleFunc.
+func (m *FirewallAPIMock) RemoveIPv4FilterRule(id string) error {
+	m.record("RemoveIPv4FilterRule", id)
+	if m.RemoveIPv4FilterRuleFunc == nil {
+		panic("FirewallAPIMock.RemoveIPv4FilterRuleFunc: method is nil but RemoveIPv4FilterRule was just called")
+	}
+	return m.RemoveIPv4FilterRuleFunc(id)
+}
+
+// EnableIPv4FilterRule calls EnableIPv4FilterRuleFunc.
+func (m *FirewallAPIMock) EnableIPv4FilterRule(id string) error {
+	m.record("EnableIPv4FilterRule", id)
+	if m.EnableIPv4FilterRuleFunc == nil {
+		panic("FirewallAPIMock.EnableIPv4FilterRuleFunc: method is nil but EnableIPv4FilterRule was just called")
+	}
+	return m.EnableIPv4FilterRuleFunc(id)
+}
+
+// DisableIPv4FilterRule calls DisableIPv4FilterRuleFunc.
+func (m *FirewallAPIMock) DisableIPv4FilterRule(id string) error {
+	m.record("DisableIPv4FilterRule", id)
+	if m.DisableIPv4FilterRuleFunc == nil {
+		panic("FirewallAPIMock.DisableIPv4FilterRuleFunc: method is nil but DisableIPv4FilterRule was just called")
+	}
+	return m.DisableIPv4FilterRuleFunc(id)
+}
+
+// ResetFilterCounters calls ResetFilterCountersFunc.
+func (m *FirewallAPIMock) ResetFilterCounters(chain string) error {
+	m.record("ResetFilterCounters", chain)
+	if m.ResetFilterCountersFunc == nil {
+		panic("FirewallAPIMock.ResetFilterCountersFunc: method is nil but ResetFilterCounters was just called")
+	}
+	return m.ResetFilterCountersFunc(chain)
+}
+
+// ResetCounters calls ResetCountersFunc.
+func (m *FirewallAPIMock) ResetCounters(id string) error {
+	m.record("ResetCounters", id)
+	if m.ResetCountersFunc == nil {
+		panic("FirewallAPIMock.ResetCountersFunc: method is nil but ResetCounters was just called")
+	}
+	return m.ResetCountersFunc(id)
+}
+
+// SampleFilterRates calls SampleFilterRatesFunc.
+func (m *FirewallAPIMock) SampleFilterRates(ctx context.Context, chain string, interval time.Duration) (<-chan gotik.FilterSample, error) {
+	m.record("SampleFilterRates", ctx, chain, interval)
+	if m.SampleFilterRatesFunc == nil {
+		panic("FirewallAPIMock.SampleFilterRatesFunc: method is nil but SampleFilterRates was just called")
+	}
+	return m.SampleFilterRatesFunc(ctx, chain, interval)
+}
+
+// ApplyFilterChain calls ApplyFilterChainFunc.
+func (m *FirewallAPIMock) ApplyFilterChain(chain string, desired []gotik.IPv4FilterRule) ([]gotik.ChainChange, error) {
+	m.record("ApplyFilterChain", chain, desired)
+	if m.ApplyFilterChainFunc == nil {
+		panic("FirewallAPIMock.ApplyFilterChainFunc: method is nil but ApplyFilterChain was just called")
+	}
+	return m.ApplyFilterChainFunc(chain, desired)
+}
+
+// GetIPv4Nat calls GetIPv4NatFunc.
+func (m *FirewallAPIMock) GetIPv4Nat(chain string) ([]gotik.IPv4NatRule, error) {
+	m.record("GetIPv4Nat", chain)
+	if m.GetIPv4NatFunc == nil {
+		panic("FirewallAPIMock.GetIPv4NatFunc: method is nil but GetIPv4Nat was just called")
+	}
+	return m.GetIPv4NatFunc(chain)
+}
+
+// RemoveIPv4NatRule calls RemoveIPv4NatRuleFunc.
+func (m *FirewallAPIMock) RemoveIPv4NatRule(id string) error {
+	m.record("RemoveIPv4NatRule", id)
+	if m.RemoveIPv4NatRuleFunc == nil {
+		panic("FirewallAPIMock.RemoveIPv4NatRuleFunc: method is nil but RemoveIPv4NatRule was just called")
+	}
+	return m.RemoveIPv4NatRuleFunc(id)
+}
+
+// AddPortForward calls AddPortForwardFunc.
+func (m *FirewallAPIMock) AddPortForward(inInterface string, protocol string, dstPort gotik.PortSet, toAddress string, toPorts gotik.PortSet, comment string) error {
+	m.record("AddPortForward", inInterface, protocol, dstPort, toAddress, toPorts, comment)
+	if m.AddPortForwardFunc == nil {
+		panic("FirewallAPIMock.AddPortForwardFunc: method is nil but AddPortForward was just called")
+	}
+	return m.AddPortForwardFunc(inInterface, protocol, dstPort, toAddress, toPorts, comment)
+}
+
+// AddMasquerade calls AddMasqueradeFunc.
+func (m *FirewallAPIMock) AddMasquerade(outInterface string, comment string) error {
+	m.record("AddMasquerade", outInterface, comment)
+	if m.AddMasqueradeFunc == nil {
+		panic("FirewallAPIMock.AddMasqueradeFunc: method is nil but AddMasquerade was just called")
+	}
+	return m.AddMasqueradeFunc(outInterface, comment)
+}
+
+// AddSrcNAT calls AddSrcNATFunc.
+func (m *FirewallAPIMock) AddSrcNAT(srcAddress string, outInterface string, toAddress string, comment string) error {
+	m.record("AddSrcNAT", srcAddress, outInterface, toAddress, comment)
+	if m.AddSrcNATFunc == nil {
+		panic("FirewallAPIMock.AddSrcNATFunc: method is nil but AddSrcNAT was just called")
+	}
+	return m.AddSrcNATFunc(srcAddress, outInterface, toAddress, comment)
+}
+
+// GetIPv4Mangle calls GetIPv4MangleFunc.
+func (m *FirewallAPIMock) GetIPv4Mangle(chain string) ([]gotik.IPv4MangleRule, error) {
+	m.record("GetIPv4Mangle", chain)
+	if m.GetIPv4MangleFunc == nil {
+		panic("FirewallAPIMock.GetIPv4MangleFunc: method is nil but GetIPv4Mangle was just called")
+	}
+	return m.GetIPv4MangleFunc(chain)
+}
+
+// AddIPv4MangleRule calls AddIPv4MangleRuleFunc.
+func (m *FirewallAPIMock) AddIPv4MangleRule(rule gotik.IPv4MangleRule) (string, error) {
+	m.record("AddIPv4MangleRule", rule)
+	if m.AddIPv4MangleRuleFunc == nil {
+		panic("FirewallAPIMock.AddIPv4MangleRuleFunc: method is nil but AddIPv4MangleRule was just called")
+	}
+	return m.AddIPv4MangleRuleFunc(rule)
+}
+
+// RemoveIPv4MangleRule calls RemoveIPv4MangleRuleFunc.
+func (m *FirewallAPIMock) RemoveIPv4MangleRule(id string) error {
+	m.record("RemoveIPv4MangleRule", id)
+	if m.RemoveIPv4MangleRuleFunc == nil {
+		panic("FirewallAPIMock.RemoveIPv4MangleRuleFunc: method is nil but RemoveIPv4MangleRule was just called")
+	}
+	return m.RemoveIPv4MangleRuleFunc(id)
+}
+
+// EnableIPv4MangleRule calls EnableIPv4MangleRuleFunc.
+func (m *FirewallAPIMock) EnableIPv4MangleRule(id string) error {
+	m.record("EnableIPv4MangleRule", id)
+	if m.EnableIPv4MangleRuleFunc == nil {
+		panic("FirewallAPIMock.EnableIPv4MangleRuleFunc: method is nil but EnableIPv4MangleRule was just called")
+	}
+	return m.EnableIPv4MangleRuleFunc(id)
+}
+
+// DisableIPv4MangleRule calls DisableIPv4MangleRuleFunc.
+func (m *FirewallAPIMock) DisableIPv4MangleRule(id string) error {
+	m.record("DisableIPv4MangleRule", id)
+	if m.DisableIPv4MangleRuleFunc == nil {
+		panic("FirewallAPIMock.DisableIPv4MangleRuleFunc: method is nil but DisableIPv4MangleRule was just called")
+	}
+	return m.DisableIPv4MangleRuleFunc(id)
+}
+
+// GetIPv4Raw calls GetIPv4RawFunc.
+func (m *FirewallAPIMock) GetIPv4Raw(chain string) ([]gotik.IPv4RawRule, error) {
+	m.record("GetIPv4Raw", chain)
+	if m.GetIPv4RawFunc == nil {
+		panic("FirewallAPIMock.GetIPv4RawFunc: method is nil but GetIPv4Raw was just called")
+	}
+	return m.GetIPv4RawFunc(chain)
+}
+
+// AddIPv4RawRule calls AddIPv4RawRuleFunc.
+func (m *FirewallAPIMock) AddIPv4RawRule(rule gotik.IPv4RawRule) (string, error) {
+	m.record("AddIPv4RawRule", rule)
+	if m.AddIPv4RawRuleFunc == nil {
+		panic("FirewallAPIMock.AddIPv4RawRuleFunc: method is nil but AddIPv4RawRule was just called")
+	}
+	return m.AddIPv4RawRuleFunc(rule)
+}
+
+// RemoveIPv4RawRule calls RemoveIPv4RawRuleFunc.
+func (m *FirewallAPIMock) RemoveIPv4RawRule(id string) error {
+	m.record("RemoveIPv4RawRule", id)
+	if m.RemoveIPv4RawRuleFunc == nil {
+		panic("FirewallAPIMock.RemoveIPv4RawRuleFunc: method is nil but RemoveIPv4RawRule was just called")
+	}
+	return m.RemoveIPv4RawRuleFunc(id)
+}
+
+// EnableIPv4RawRule calls EnableIPv4RawRuleFunc.
+func (m *FirewallAPIMock) EnableIPv4RawRule(id string) error {
+	m.record("EnableIPv4RawRule", id)
+	if m.EnableIPv4RawRuleFunc == nil {
+		panic("FirewallAPIMock.EnableIPv4RawRuleFunc: method is nil but EnableIPv4RawRule was just called")
+	}
+	return m.EnableIPv4RawRuleFunc(id)
+}
+
+// DisableIPv4RawRule calls DisableIPv4RawRuleFunc.
+func (m *FirewallAPIMock) DisableIPv4RawRule(id string) error {
+	m.record("DisableIPv4RawRule", id)
+	if m.DisableIPv4RawRuleFunc == nil {
+		panic("FirewallAPIMock.DisableIPv4RawRuleFunc: method is nil but DisableIPv4RawRule was just called")
+	}
+	return m.DisableIPv4RawRuleFunc(id)
+}
+
+// GetIPv6Filters calls GetIPv6FiltersFunc.
+func (m *FirewallAPIMock) GetIPv6Filters(chain string) ([]gotik.IPv6FilterRule, error) {
+	m.record("GetIPv6Filters", chain)
+	if m.GetIPv6FiltersFunc == nil {
+		panic("FirewallAPIMock.GetIPv6FiltersFunc: method is nil but GetIPv6Filters was just called")
+	}
+	return m.GetIPv6FiltersFunc(chain)
+}
+
+// AddIPv6FilterRule calls AddIPv6FilterRuleFunc.
+func (m *FirewallAPIMock) AddIPv6FilterRule(rule gotik.IPv6FilterRule) (string, error) {
+	m.record("AddIPv6FilterRule", rule)
+	if m.AddIPv6FilterRuleFunc == nil {
+		panic("FirewallAPIMock.AddIPv6FilterRuleFunc: method is nil but AddIPv6FilterRule was just called")
+	}
+	return m.AddIPv6FilterRuleFunc(rule)
+}
+
+// RemoveIPv6FilterRule calls RemoveIPv6FilterRuleFunc.
+func (m *FirewallAPIMock) RemoveIPv6FilterRule(id string) error {
+	m.record("RemoveIPv6FilterRule", id)
+	if m.RemoveIPv6FilterRuleFunc == nil {
+		panic("FirewallAPIMock.RemoveIPv6FilterRuleFunc: method is nil but RemoveIPv6FilterRule was just called")
+	}
+	return m.RemoveIPv6FilterRuleFunc(id)
+}
+
+// EnableIPv6FilterRule calls EnableIPv6FilterRuleFunc.
+func (m *FirewallAPIMock) EnableIPv6FilterRule(id string) error {
+	m.record("EnableIPv6FilterRule", id)
+	if m.EnableIPv6FilterRuleFunc == nil {
+		panic("FirewallAPIMock.EnableIPv6FilterRuleFunc: method is nil but EnableIPv6FilterRule was just called")
+	}
+	return m.EnableIPv6FilterRuleFunc(id)
+}
+
+// DisableIPv6FilterRule calls DisableIPv6FilterRuleFunc.
+func (m *FirewallAPIMock) DisableIPv6FilterRule(id string) error {
+	m.record("DisableIPv6FilterRule", id)
+	if m.DisableIPv6FilterRuleFunc == nil {
+		panic("FirewallAPIMock.DisableIPv6FilterRuleFunc: method is nil but DisableIPv6FilterRule was just called")
+	}
+	return m.DisableIPv6FilterRuleFunc(id)
+}
+
+// GetIPv6Nat calls GetIPv6NatFunc.
+func (m *FirewallAPIMock) GetIPv6Nat(chain string) ([]gotik.IPv6NatRule, error) {
+	m.record("GetIPv6Nat", chain)
+	if m.GetIPv6NatFunc == nil {
+		panic("FirewallAPIMock.GetIPv6NatFunc: method is nil but GetIPv6Nat was just called")
+	}
+	return m.GetIPv6NatFunc(chain)
+}
+
+// AddIPv6NatRule calls AddIPv6NatRuleFunc.
+func (m *FirewallAPIMock) AddIPv6NatRule(rule gotik.IPv6NatRule) (string, error) {
+	m.record("AddIPv6NatRule", rule)
+	if m.AddIPv6NatRuleFunc == nil {
+		panic("FirewallAPIMock.AddIPv6NatRuleFunc: method is nil but AddIPv6NatRule was just called")
+	}
+	return m.AddIPv6NatRuleFunc(rule)
+}
+
+// RemoveIPv6NatRule calls RemoveIPv6NatRuleFunc.
+func (m *FirewallAPIMock) RemoveIPv6NatRule(id string) error {
+	m.record("RemoveIPv6NatRule", id)
+	if m.RemoveIPv6NatRuleFunc == nil {
+		panic("FirewallAPIMock.RemoveIPv6NatRuleFunc: method is nil but RemoveIPv6NatRule was just called")
+	}
+	return m.RemoveIPv6NatRuleFunc(id)
+}
+
+// EnableIPv6NatRule calls EnableIPv6NatRuleFunc.
+func (m *FirewallAPIMock) EnableIPv6NatRule(id string) error {
+	m.record("EnableIPv6NatRule", id)
+	if m.EnableIPv6NatRuleFunc == nil {
+		panic("FirewallAPIMock.EnableIPv6NatRuleFunc: method is nil but EnableIPv6NatRule was just called")
+	}
+	return m.EnableIPv6NatRuleFunc(id)
+}
+
+// DisableIPv6NatRule calls DisableIPv6NatRuleFunc.
+func (m *FirewallAPIMock) DisableIPv6NatRule(id string) error {
+	m.record("DisableIPv6NatRule", id)
+	if m.DisableIPv6NatRuleFunc == nil {
+		panic("FirewallAPIMock.DisableIPv6NatRuleFunc: method is nil but DisableIPv6NatRule was just called")
+	}
+	return m.DisableIPv6NatRuleFunc(id)
+}
+
+// GetIPv6Mangle calls GetIPv6MangleFunc.
+func (m *FirewallAPIMock) GetIPv6Mangle(chain string) ([]gotik.IPv6MangleRule, error) {
+	m.record("GetIPv6Mangle", chain)
+	if m.GetIPv6MangleFunc == nil {
+		panic("FirewallAPIMock.GetIPv6MangleFunc: method is nil but GetIPv6Mangle was just called")
+	}
+	return m.GetIPv6MangleFunc(chain)
+}
+
+// AddIPv6MangleRule calls AddIPv6MangleRuleFunc.
+func (m *FirewallAPIMock) AddIPv6MangleRule(rule gotik.IPv6MangleRule) (string, error) {
+	m.record("AddIPv6MangleRule", rule)
+	if m.AddIPv6MangleRuleFunc == nil {
+		panic("FirewallAPIMock.AddIPv6MangleRuleFunc: method is nil but AddIPv6MangleRule was just called")
+	}
+	return m.AddIPv6MangleRuleFunc(rule)
+}
+
+// RemoveIPv6MangleRule calls RemoveIPv6MangleRuleFunc.
+func (m *FirewallAPIMock) RemoveIPv6MangleRule(id string) error {
+	m.record("RemoveIPv6MangleRule", id)
+	if m.RemoveIPv6MangleRuleFunc == nil {
+		panic("FirewallAPIMock.RemoveIPv6MangleRuleFunc: method is nil but RemoveIPv6MangleRule was just called")
+	}
+	return m.RemoveIPv6MangleRuleFunc(id)
+}
+
+// EnableIPv6MangleRule calls EnableIPv6MangleRuleFunc.
+func (m *FirewallAPIMock) EnableIPv6MangleRule(id string) error {
+	m.record("EnableIPv6MangleRule", id)
+	if m.EnableIPv6MangleRuleFunc == nil {
+		panic("FirewallAPIMock.EnableIPv6MangleRuleFunc: method is nil but EnableIPv6MangleRule was just called")
+	}
+	return m.EnableIPv6MangleRuleFunc(id)
+}
+
+// DisableIPv6MangleRule calls DisableIPv6MangleRuleFunc.
+func (m *FirewallAPIMock) DisableIPv6MangleRule(id string) error {
+	m.record("DisableIPv6MangleRule", id)
+	if m.DisableIPv6MangleRuleFunc == nil {
+		panic("FirewallAPIMock.DisableIPv6MangleRuleFunc: method is nil but DisableIPv6MangleRule was just called")
+	}
+	return m.DisableIPv6MangleRuleFunc(id)
+}
+
+// GetIPv6Raw calls GetIPv6RawFunc.
+func (m *FirewallAPIMock) GetIPv6Raw(chain string) ([]gotik.IPv6RawRule, error) {
+	m.record("GetIPv6Raw", chain)
+	if m.GetIPv6RawFunc == nil {
+		panic("FirewallAPIMock.GetIPv6RawFunc: method is nil but GetIPv6Raw was just called")
+	}
+	return m.GetIPv6RawFunc(chain)
+}
+
+// AddIPv6RawRule calls AddIPv6RawRuleFunc.
+func (m *FirewallAPIMock) AddIPv6RawRule(rule gotik.IPv6RawRule) (string, error) {
+	m.record("AddIPv6RawRule", rule)
+	if m.AddIPv6RawRuleFunc == nil {
+		panic("FirewallAPIMock.AddIPv6RawRuleFunc: method is nil but AddIPv6RawRule was just called")
+	}
+	return m.AddIPv6RawRuleFunc(rule)
+}
+
+// RemoveIPv6RawRule calls RemoveIPv6RawRuleFunc.
+func (m *FirewallAPIMock) RemoveIPv6RawRule(id string) error {
+	m.record("RemoveIPv6RawRule", id)
+	if m.RemoveIPv6RawRuleFunc == nil {
+		panic("FirewallAPIMock.RemoveIPv6RawRuleFunc: method is nil but RemoveIPv6RawRule was just called")
+	}
+	return m.RemoveIPv6RawRuleFunc(id)
+}
+
+// EnableIPv6RawRule calls EnableIPv6RawRuleFunc.
+func (m *FirewallAPIMock) EnableIPv6RawRule(id string) error {
+	m.record("EnableIPv6RawRule", id)
+	if m.EnableIPv6RawRuleFunc == nil {
+		panic("FirewallAPIMock.EnableIPv6RawRuleFunc: method is nil but EnableIPv6RawRule was just called")
+	}
+	return m.EnableIPv6RawRuleFunc(id)
+}
+
+// DisableIPv6RawRule calls DisableIPv6RawRuleFunc.
+func (m *FirewallAPIMock) DisableIPv6RawRule(id string) error {
+	m.record("DisableIPv6RawRule", id)
+	if m.DisableIPv6RawRuleFunc == nil {
+		panic("FirewallAPIMock.DisableIPv6RawRuleFunc: method is nil but DisableIPv6RawRule was just called")
+	}
+	return m.DisableIPv6RawRuleFunc(id)
+}
+
+// GetIDS calls GetIDSFunc.
+func (m *FirewallAPIMock) GetIDS(in interface{}) ([]string, string, error) {
+	m.record("GetIDS", in)
+	if m.GetIDSFunc == nil {
+		panic("FirewallAPIMock.GetIDSFunc: method is nil but GetIDS was just called")
+	}
+	return m.GetIDSFunc(in)
+}
+
+// CommitRule calls CommitRuleFunc.
+func (m *FirewallAPIMock) CommitRule(in interface{}) error {
+	m.record("CommitRule", in)
+	if m.CommitRuleFunc == nil {
+		panic("FirewallAPIMock.CommitRuleFunc: method is nil but CommitRule was just called")
+	}
+	return m.CommitRuleFunc(in)
+}
+
+// AddRule calls AddRuleFunc.
+func (m *FirewallAPIMock) AddRule(in interface{}) error {
+	m.record("AddRule", in)
+	if m.AddRuleFunc == nil {
+		panic("FirewallAPIMock.AddRuleFunc: method is nil but AddRule was just called")
+	}
+	return m.AddRuleFunc(in)
+}
+
+// RemoveRule calls RemoveRuleFunc.
+func (m *FirewallAPIMock) RemoveRule(in interface{}) error {
+	m.record("RemoveRule", in)
+	if m.RemoveRuleFunc == nil {
+		panic("FirewallAPIMock.RemoveRuleFunc: method is nil but RemoveRule was just called")
+	}
+	return m.RemoveRuleFunc(in)
+}
+
+// RemoveRuleByID calls RemoveRuleByIDFunc.
+func (m *FirewallAPIMock) RemoveRuleByID(in interface{}) error {
+	m.record("RemoveRuleByID", in)
+	if m.RemoveRuleByIDFunc == nil {
+		panic("FirewallAPIMock.RemoveRuleByIDFunc: method is nil but RemoveRuleByID was just called")
+	}
+	return m.RemoveRuleByIDFunc(in)
+}
+
+// ModifyRule calls ModifyRuleFunc.
+func (m *FirewallAPIMock) ModifyRule(in interface{}, action string) error {
+	m.record("ModifyRule", in, action)
+	if m.ModifyRuleFunc == nil {
+		panic("FirewallAPIMock.ModifyRuleFunc: method is nil but ModifyRule was just called")
+	}
+	return m.ModifyRuleFunc(in, action)
+}
+
+// MoveRule calls MoveRuleFunc.
+func (m *FirewallAPIMock) MoveRule(in interface{}, before string) error {
+	m.record("MoveRule", in, before)
+	if m.MoveRuleFunc == nil {
+		panic("FirewallAPIMock.MoveRuleFunc: method is nil but MoveRule was just called")
+	}
+	return m.MoveRuleFunc(in, before)
+}
+
+// RuleIsDisabled calls RuleIsDisabledFunc.
+func (m *FirewallAPIMock) RuleIsDisabled(in interface{}) (bool, error) {
+	m.record("RuleIsDisabled", in)
+	if m.RuleIsDisabledFunc == nil {
+		panic("FirewallAPIMock.RuleIsDisabledFunc: method is nil but RuleIsDisabled was just called")
+	}
+	return m.RuleIsDisabledFunc(in)
+}
+
+// AddObject calls AddObjectFunc.
+func (m *FirewallAPIMock) AddObject(in interface{}) error {
+	m.record("AddObject", in)
+	if m.AddObjectFunc == nil {
+		panic("FirewallAPIMock.AddObjectFunc: method is nil but AddObject was just called")
+	}
+	return m.AddObjectFunc(in)
+}
+
+// GetIPv4AddressList calls GetIPv4AddressListFunc.
+func (m *FirewallAPIMock) GetIPv4AddressList(listname string) ([]gotik.AddressList, error) {
+	m.record("GetIPv4AddressList", listname)
+	if m.GetIPv4AddressListFunc == nil {
+		panic("FirewallAPIMock.GetIPv4AddressListFunc: method is nil but GetIPv4AddressList was just called")
+	}
+	return m.GetIPv4AddressListFunc(listname)
+}
+
+// GetIPv6AddressList calls GetIPv6AddressListFunc.
+func (m *FirewallAPIMock) GetIPv6AddressList(listname string) ([]gotik.AddressList, error) {
+	m.record("GetIPv6AddressList", listname)
+	if m.GetIPv6AddressListFunc == nil {
+		panic("FirewallAPIMock.GetIPv6AddressListFunc: method is nil but GetIPv6AddressList was just called")
+	}
+	return m.GetIPv6AddressListFunc(listname)
+}
+
+// AuditIPv4AddressList calls AuditIPv4AddressListFunc.
+func (m *FirewallAPIMock) AuditIPv4AddressList(listname string, list []gotik.AddressList, goodList map[string]string, applyAudits bool) ([]gotik.AddressListAudit, error) {
+	m.record("AuditIPv4AddressList", listname, list, goodList, applyAudits)
+	if m.AuditIPv4AddressListFunc == nil {
+		panic("FirewallAPIMock.AuditIPv4AddressListFunc: method is nil but AuditIPv4AddressList was just called")
+	}
+	return m.AuditIPv4AddressListFunc(listname, list, goodList, applyAudits)
+}
+
+// AuditIPv6AddressList calls AuditIPv6AddressListFunc.
+func (m *FirewallAPIMock) AuditIPv6AddressList(listname string, list []gotik.AddressList, goodList map[string]string, applyAudits bool) ([]gotik.AddressListAudit, error) {
+	m.record("AuditIPv6AddressList", listname, list, goodList, applyAudits)
+	if m.AuditIPv6AddressListFunc == nil {
+		panic("FirewallAPIMock.AuditIPv6AddressListFunc: method is nil but AuditIPv6AddressList was just called")
+	}
+	return m.AuditIPv6AddressListFunc(listname, list, goodList, applyAudits)
+}
+
+// GetConnections calls GetConnectionsFunc.
+func (m *FirewallAPIMock) GetConnections(f gotik.ConnectionFilter) ([]gotik.Connection, error) {
+	m.record("GetConnections", f)
+	if m.GetConnectionsFunc == nil {
+		panic("FirewallAPIMock.GetConnectionsFunc: method is nil but GetConnections was just called")
+	}
+	return m.GetConnectionsFunc(f)
+}
+
+// ListenConnections calls ListenConnectionsFunc.
+func (m *FirewallAPIMock) ListenConnections(f gotik.ConnectionFilter, fn func(arg0 gotik.Connection) error) error {
+	m.record("ListenConnections", f, fn)
+	if m.ListenConnectionsFunc == nil {
+		panic("FirewallAPIMock.ListenConnectionsFunc: method is nil but ListenConnections was just called")
+	}
+	return m.ListenConnectionsFunc(f, fn)
+}
+
+// RemoveConnections calls RemoveConnectionsFunc.
+func (m *FirewallAPIMock) RemoveConnections(f gotik.ConnectionFilter) (int, error) {
+	m.record("RemoveConnections", f)
+	if m.RemoveConnectionsFunc == nil {
+		panic("FirewallAPIMock.RemoveConnectionsFunc: method is nil but RemoveConnections was just called")
+	}
+	return m.RemoveConnectionsFunc(f)
+}
+
+// GetConnectionTracking calls GetConnectionTrackingFunc.
+func (m *FirewallAPIMock) GetConnectionTracking() (gotik.ConnectionTracking, error) {
+	m.record("GetConnectionTracking")
+	if m.GetConnectionTrackingFunc == nil {
+		panic("FirewallAPIMock.GetConnectionTrackingFunc: method is nil but GetConnectionTracking was just called")
+	}
+	return m.GetConnectionTrackingFunc()
+}
+
+// SetConnectionTracking calls SetConnectionTrackingFunc.
+func (m *FirewallAPIMock) SetConnectionTracking(settings gotik.ConnectionTracking) error {
+	m.record("SetConnectionTracking", settings)
+	if m.SetConnectionTrackingFunc == nil {
+		panic("FirewallAPIMock.SetConnectionTrackingFunc: method is nil but SetConnectionTracking was just called")
+	}
+	return m.SetConnectionTrackingFunc(settings)
+}
+
+// InterfaceAPIMock is a mock implementation of gotik.InterfaceAPI.
+type InterfaceAPIMock struct {
+	// GetInterfacesOfTypesFunc mocks the GetInterfacesOfTypes method.
+	GetInterfacesOfTypesFunc func(types ...string) ([]gotik.Interface, error)
+
+	// GetEthInterfacesFunc mocks the GetEthInterfaces method.
+	GetEthInterfacesFunc func() ([]gotik.Interface, error)
+
+	// GetBridgeInterfacesFunc mocks the GetBridgeInterfaces method.
+	GetBridgeInterfacesFunc func() ([]gotik.Interface, error)
+
+	// GetVlanInterfacesFunc mocks the GetVlanInterfaces method.
+	GetVlanInterfacesFunc func(baseIntf string) ([]gotik.Interface, error)
+
+	// GetVLANInterfaceFunc mocks the GetVLANInterface method.
+	GetVLANInterfaceFunc func(vlan int) (gotik.Interface, error)
+
+	// GetVLANInterfaceOnBaseFunc mocks the GetVLANInterfaceOnBase method.
+	GetVLANInterfaceOnBaseFunc func(baseIntf string, vlan int) (gotik.Interface, error)
+
+	// AddVLANInterfaceFunc mocks the AddVLANInterface method.
+	AddVLANInterfaceFunc func(intf gotik.Interface) (string, error)
+
+	// EnableInterfaceFunc mocks the EnableInterface method.
+	EnableInterfaceFunc func(id string) error
+
+	// DisableInterfaceFunc mocks the DisableInterface method.
+	DisableInterfaceFunc func(id string) error
+
+	// SetInterfaceCommentFunc mocks the SetInterfaceComment method.
+	SetInterfaceCommentFunc func(id string, comment string) error
+
+	// SetInterfaceNameFunc mocks the SetInterfaceName method.
+	SetInterfaceNameFunc func(id string, newName string) error
+
+	// GetArpTableFunc mocks the GetArpTable method.
+	GetArpTableFunc func() ([]gotik.ArpEntry, error)
+
+	// GetInterfaceArpTableFunc mocks the GetInterfaceArpTable method.
+	GetInterfaceArpTableFunc func(baseIntf string) ([]gotik.ArpEntry, error)
+
+	// ArpLookupByIPFunc mocks the ArpLookupByIP method.
+	ArpLookupByIPFunc func(ipv4 string) (gotik.ArpEntry, error)
+
+	// ArpLookupByMACFunc mocks the ArpLookupByMAC method.
+	ArpLookupByMACFunc func(mac string) (gotik.ArpEntry, error)
+
+	// GetNeighborInterfaceFunc mocks the GetNeighborInterface method.
+	GetNeighborInterfaceFunc func(iface string) (gotik.NeighborInterface, error)
+
+	// ModifyNeighborFunc mocks the ModifyNeighbor method.
+	ModifyNeighborFunc func(id string, action string) error
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made on the mock so far, in order.
+func (m *InterfaceAPIMock) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *InterfaceAPIMock) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+	m.mu.Unlock()
+}
+
+// GetInterfacesOfTypes calls GetInterfacesOfTypesFunc.
+func (m *InterfaceAPIMock) GetInterfacesOfTypes(types ...string) ([]gotik.Interface, error) {
+	m.record("GetInterfacesOfTypes", types)
+	if m.GetInterfacesOfTypesFunc == nil {
+		panic("InterfaceAPIMock.GetInterfacesOfTypesFunc: method is nil but GetInterfacesOfTypes was just called")
+	}
+	return m.GetInterfacesOfTypesFunc(types...)
+}
+
+// GetEthInterfaces calls GetEthInterfacesFunc.
+func (m *InterfaceAPIMock) GetEthInterfaces() ([]gotik.Interface, error) {
+	m.record("GetEthInterfaces")
+	if m.GetEthInterfacesFunc == nil {
+		panic("InterfaceAPIMock.GetEthInterfacesFunc: method is nil but GetEthInterfaces was just called")
+	}
+	return m.GetEthInterfacesFunc()
+}
+
+// GetBridgeInterfaces calls GetBridgeInterfacesFunc.
+func (m *InterfaceAPIMock) GetBridgeInterfaces() ([]gotik.Interface, error) {
+	m.record("GetBridgeInterfaces")
+	if m.GetBridgeInterfacesFunc == nil {
+		panic("InterfaceAPIMock.GetBridgeInterfacesFunc: method is nil but GetBridgeInterfaces was just called")
+	}
+	return m.GetBridgeInterfacesFunc()
+}
+
+// GetVlanInterfaces calls GetVlanInterfacesFunc.
+func (m *InterfaceAPIMock) GetVlanInterfaces(baseIntf string) ([]gotik.Interface, error) {
+	m.record("GetVlanInterfaces", baseIntf)
+	if m.GetVlanInterfacesFunc == nil {
+		panic("InterfaceAPIMock.GetVlanInterfacesFunc: method is nil but GetVlanInterfaces was just called")
+	}
+	return m.GetVlanInterfacesFunc(baseIntf)
+}
+
+// GetVLANInterface calls GetVLANInterfaceFunc.
+func (m *InterfaceAPIMock) GetVLANInterface(vlan int) (gotik.Interface, error) {
+	m.record("GetVLANInterface", vlan)
+	if m.GetVLANInterfaceFunc == nil {
+		panic("InterfaceAPIMock.GetVLANInterfaceFunc: method is nil but GetVLANInterface was just called")
+	}
+	return m.GetVLANInterfaceFunc(vlan)
+}
+
+// GetVLANInterfaceOnBase calls GetVLANInterfaceOnBaseFunc.
+func (m *InterfaceAPIMock) GetVLANInterfaceOnBase(baseIntf string, vlan int) (gotik.Interface, error) {
+	m.record("GetVLANInterfaceOnBase", baseIntf, vlan)
+	if m.GetVLANInterfaceOnBaseFunc == nil {
+		panic("InterfaceAPIMock.GetVLANInterfaceOnBaseFunc: method is nil but GetVLANInterfaceOnBase was just called")
+	}
+	return m.GetVLANInterfaceOnBaseFunc(baseIntf, vlan)
+}
+
+// AddVLANInterface calls AddVLANInterfaceFunc.
+func (m *InterfaceAPIMock) AddVLANInterface(intf gotik.Interface) (string, error) {
+	m.record("AddVLANInterface", intf)
+	if m.AddVLANInterfaceFunc == nil {
+		panic("InterfaceAPIMock.AddVLANInterfaceFunc: method is nil but AddVLANInterface was just called")
+	}
+	return m.AddVLANInterfaceFunc(intf)
+}
+
+// EnableInterface calls EnableInterfaceFunc.
+func (m *InterfaceAPIMock) EnableInterface(id string) error {
+	m.record("EnableInterface", id)
+	if m.EnableInterfaceFunc == nil {
+		panic("InterfaceAPIMock.EnableInterfaceFunc: method is nil but EnableInterface was just called")
+	}
+	return m.EnableInterfaceFunc(id)
+}
+
+// DisableInterface calls DisableInterfaceFunc.
+func (m *InterfaceAPIMock) DisableInterface(id string) error {
+	m.record("DisableInterface", id)
+	if m.DisableInterfaceFunc == nil {
+		panic("InterfaceAPIMock.DisableInterfaceFunc: method is nil but DisableInterface was just called")
+	}
+	return m.DisableInterfaceFunc(id)
+}
+
+// SetInterfaceComment calls SetInterfaceCommentFunc.
+func (m *InterfaceAPIMock) SetInterfaceComment(id string, comment string) error {
+	m.record("SetInterfaceComment", id, comment)
+	if m.SetInterfaceCommentFunc == nil {
+		panic("InterfaceAPIMock.SetInterfaceCommentFunc: method is nil but SetInterfaceComment was just called")
+	}
+	return m.SetInterfaceCommentFunc(id, comment)
+}
+
+// SetInterfaceName calls SetInterfaceNameFunc.
+func (m *InterfaceAPIMock) SetInterfaceName(id string, newName string) error {
+	m.record("SetInterfaceName", id, newName)
+	if m.SetInterfaceNameFunc == nil {
+		panic("InterfaceAPIMock.SetInterfaceNameFunc: method is nil but SetInterfaceName was just called")
+	}
+	return m.SetInterfaceNameFunc(id, newName)
+}
+
+// GetArpTable calls GetArpTableFunc.
+func (m *InterfaceAPIMock) GetArpTable() ([]gotik.ArpEntry, error) {
+	m.record("GetArpTable")
+	if m.GetArpTableFunc == nil {
+		panic("InterfaceAPIMock.GetArpTableFunc: method is nil but GetArpTable was just called")
+	}
+	return m.GetArpTableFunc()
+}
+
+// GetInterfaceArpTable calls GetInterfaceArpTableFunc.
+func (m *InterfaceAPIMock) GetInterfaceArpTable(baseIntf string) ([]gotik.ArpEntry, error) {
+	m.record("GetInterfaceArpTable", baseIntf)
+	if m.GetInterfaceArpTableFunc == nil {
+		panic("InterfaceAPIMock.GetInterfaceArpTableFunc: method is nil but GetInterfaceArpTable was just called")
+	}
+	return m.GetInterfaceArpTableFunc(baseIntf)
+}
+
+// ArpLookupByIP calls ArpLookupByIPFunc.
+func (m *InterfaceAPIMock) ArpLookupByIP(ipv4 string) (gotik.ArpEntry, error) {
+	m.record("ArpLookupByIP", ipv4)
+	if m.ArpLookupByIPFunc == nil {
+		panic("InterfaceAPIMock.ArpLookupByIPFunc: method is nil but ArpLookupByIP was just called")
+	}
+	return m.ArpLookupByIPFunc(ipv4)
+}
+
+// ArpLookupByMAC calls ArpLookupByMACFunc.
+func (m *InterfaceAPIMock) ArpLookupByMAC(mac string) (gotik.ArpEntry, error) {
+	m.record("ArpLookupByMAC", mac)
+	if m.ArpLookupByMACFunc == nil {
+		panic("InterfaceAPIMock.ArpLookupByMACFunc: method is nil but ArpLookupByMAC was just called")
+	}
+	return m.ArpLookupByMACFunc(mac)
+}
+
+// GetNeighborInterface calls GetNeighborInterfaceFunc.
+func (m *InterfaceAPIMock) GetNeighborInterface(iface string) (gotik.NeighborInterface, error) {
+	m.record("GetNeighborInterface", iface)
+	if m.GetNeighborInterfaceFunc == nil {
+		panic("InterfaceAPIMock.GetNeighborInterfaceFunc: method is nil but GetNeighborInterface was just called")
+	}
+	return m.GetNeighborInterfaceFunc(iface)
+}
+
+// ModifyNeighbor calls ModifyNeighborFunc.
+func (m *InterfaceAPIMock) ModifyNeighbor(id string, action string) error {
+	m.record("ModifyNeighbor", id, action)
+	if m.ModifyNeighborFunc == nil {
+		panic("InterfaceAPIMock.ModifyNeighborFunc: method is nil but ModifyNeighbor was just called")
+	}
+	return m.ModifyNeighborFunc(id, action)
+}
+
+// IPAPIMock is a mock implementation of gotik.IPAPI.
+type IPAPIMock struct {
+	// GetIPv4TableFunc mocks the GetIPv4Table method.
+	GetIPv4TableFunc func() ([]gotik.IPv4Address, error)
+
+	// GetInterfaceIPv4TableFunc mocks the GetInterfaceIPv4Table method.
+	GetInterfaceIPv4TableFunc func(baseIntf string) ([]gotik.IPv4Address, error)
+
+	// GetCustomerIPv4SubnetsFunc mocks the GetCustomerIPv4Subnets method.
+	GetCustomerIPv4SubnetsFunc func(vlan int) ([]gotik.IPv4Address, error)
+
+	// AddIPv4AddressFunc mocks the AddIPv4Address method.
+	AddIPv4AddressFunc func(addr gotik.IPv4Address) (string, error)
+
+	// ModifyIPv4AddressFunc mocks the ModifyIPv4Address method.
+	ModifyIPv4AddressFunc func(id string, action string) error
+
+	// GetIPv4PoolsFunc mocks the GetIPv4Pools method.
+	GetIPv4PoolsFunc func() ([]gotik.IPv4Pool, error)
+
+	// GetIPv4PoolFunc mocks the GetIPv4Pool method.
+	GetIPv4PoolFunc func(name string) ([]gotik.IPv4Pool, error)
+
+	// AddIPv4PoolFunc mocks the AddIPv4Pool method.
+	AddIPv4PoolFunc func(pool gotik.IPv4Pool) (string, error)
+
+	// GetIPv4RoutesFunc mocks the GetIPv4Routes method.
+	GetIPv4RoutesFunc func(limiters []string) ([]gotik.IPv4Route, error)
+
+	// FindMatchingIPv4RoutesFunc mocks the FindMatchingIPv4Routes method.
+	FindMatchingIPv4RoutesFunc func(routes []gotik.IPv4Route, netToMatch *net.IPNet) ([]gotik.IPv4Route, error)
+
+	// ModifyIPv4RouteFunc mocks the ModifyIPv4Route method.
+	ModifyIPv4RouteFunc func(id string, action string) error
+
+	// GetIPv6SettingsFunc mocks the GetIPv6Settings method.
+	GetIPv6SettingsFunc func() (gotik.IPv6Settings, error)
+
+	// GetDhcpv4NetworksFunc mocks the GetDhcpv4Networks method.
+	GetDhcpv4NetworksFunc func() ([]gotik.DHCP4Network, error)
+
+	// AddDhcpv4NetworkFunc mocks the AddDhcpv4Network method.
+	AddDhcpv4NetworkFunc func(s gotik.DHCP4Network) (string, error)
+
+	// GetDhcpv4ServersFunc mocks the GetDhcpv4Servers method.
+	GetDhcpv4ServersFunc func() ([]gotik.DHCPv4Server, error)
+
+	// GetDhcp4ServerByNameFunc mocks the GetDhcp4ServerByName method.
+	GetDhcp4ServerByNameFunc func(name string) ([]gotik.DHCPv4Server, error)
+
+	// GetDhcp4ServerByIntfFunc mocks the GetDhcp4ServerByIntf method.
+	GetDhcp4ServerByIntfFunc func(intf string) ([]gotik.DHCPv4Server, error)
+
+	// AddDhcpv4ServerFunc mocks the AddDhcpv4Server method.
+	AddDhcpv4ServerFunc func(s gotik.DHCPv4Server) (string, error)
+
+	// SetDhcpv4ServerDisableFunc mocks the SetDhcpv4ServerDisable method.
+	SetDhcpv4ServerDisableFunc func(id string, disabled bool) error
+
+	// GetDNSFunc mocks the GetDNS method.
+	GetDNSFunc func() (gotik.DNS, error)
+
+	// SetDNSFunc mocks the SetDNS method.
+	SetDNSFunc func(d gotik.DNS) error
+
+	// FlushDNSFunc mocks the FlushDNS method.
+	FlushDNSFunc func() error
+
+	// GetIPServicesFunc mocks the GetIPServices method.
+	GetIPServicesFunc func() ([]gotik.IPService, error)
+
+	// SetIPServiceFunc mocks the SetIPService method.
+	SetIPServiceFunc func(id string, disabled bool, port int, address string, cert string, tlsVersion string) error
+
+	// SetIPServiceDisableFunc mocks the SetIPServiceDisable method.
+	SetIPServiceDisableFunc func(id string, disabled bool) error
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made on the mock so far, in order.
+func (m *IPAPIMock) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *IPAPIMock) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+	m.mu.Unlock()
+}
+
+// GetIPv4Table calls GetIPv4TableFunc.
+func (m *IPAPIMock) GetIPv4Table() ([]gotik.IPv4Address, error) {
+	m.record("GetIPv4Table")
+	if m.GetIPv4TableFunc == nil {
+		panic("IPAPIMock.GetIPv4TableFunc: method is nil but GetIPv4Table was just called")
+	}
+	return m.GetIPv4TableFunc()
+}
+
+// GetInterfaceIPv4Table calls GetInterfaceIPv4TableFunc.
+func (m *IPAPIMock) GetInterfaceIPv4Table(baseIntf string) ([]gotik.IPv4Address, error) {
+	m.record("GetInterfaceIPv4Table", baseIntf)
+	if m.GetInterfaceIPv4TableFunc == nil {
+		panic("IPAPIMock.GetInterfaceIPv4TableFunc: method is nil but GetInterfaceIPv4Table was just called")
+	}
+	return m.GetInterfaceIPv4TableFunc(baseIntf)
+}
+
+// GetCustomerIPv4Subnets calls GetCustomerIPv4SubnetsFunc.
+func (m *IPAPIMock) GetCustomerIPv4Subnets(vlan int) ([]gotik.IPv4Address, error) {
+	m.record("GetCustomerIPv4Subnets", vlan)
+	if m.GetCustomerIPv4SubnetsFunc == nil {
+		panic("IPAPIMock.GetCustomerIPv4SubnetsFunc: method is nil but GetCustomerIPv4Subnets was just called")
+	}
+	return m.GetCustomerIPv4SubnetsFunc(vlan)
+}
+
+// AddIPv4Address calls AddIPv4AddressFunc.
+func (m *IPAPIMock) AddIPv4Address(addr gotik.IPv4Address) (string, error) {
+	m.record("AddIPv4Address", addr)
+	if m.AddIPv4AddressFunc == nil {
+		panic("IPAPIMock.AddIPv4AddressFunc: method is nil but AddIPv4Address was just called")
+	}
+	return m.AddIPv4AddressFunc(addr)
+}
+
+// ModifyIPv4Address calls ModifyIPv4AddressFunc.
+func (m *IPAPIMock) ModifyIPv4Address(id string, action string) error {
+	m.record("ModifyIPv4Address", id, action)
+	if m.ModifyIPv4AddressFunc == nil {
+		panic("IPAPIMock.ModifyIPv4AddressFunc: method is nil but ModifyIPv4Address was just called")
+	}
+	return m.ModifyIPv4AddressFunc(id, action)
+}
+
+// GetIPv4Pools calls GetIPv4PoolsFunc.
+func (m *IPAPIMock) GetIPv4Pools() ([]gotik.IPv4Pool, error) {
+	m.record("GetIPv4Pools")
+	if m.GetIPv4PoolsFunc == nil {
+		panic("IPAPIMock.GetIPv4PoolsFunc: method is nil but GetIPv4Pools was just called")
+	}
+	return m.GetIPv4PoolsFunc()
+}
+
+// GetIPv4Pool calls GetIPv4PoolFunc.
+func (m *IPAPIMock) GetIPv4Pool(name string) ([]gotik.IPv4Pool, error) {
+	m.record("GetIPv4Pool", name)
+	if m.GetIPv4PoolFunc == nil {
+		panic("IPAPIMock.GetIPv4PoolFunc: method is nil but GetIPv4Pool was just called")
+	}
+	return m.GetIPv4PoolFunc(name)
+}
+
+// AddIPv4Pool calls AddIPv4PoolFunc.
+func (m *IPAPIMock) AddIPv4Pool(pool gotik.IPv4Pool) (string, error) {
+	m.record("AddIPv4Pool", pool)
+	if m.AddIPv4PoolFunc == nil {
+		panic("IPAPIMock.AddIPv4PoolFunc: method is nil but AddIPv4Pool was just called")
+	}
+	return m.AddIPv4PoolFunc(pool)
+}
+
+// GetIPv4Routes calls GetIPv4RoutesFunc.
+func (m *IPAPIMock) GetIPv4Routes(limiters []string) ([]gotik.IPv4Route, error) {
+	m.record("GetIPv4Routes", limiters)
+	if m.GetIPv4RoutesFunc == nil {
+		panic("IPAPIMock.GetIPv4RoutesFunc: method is nil but GetIPv4Routes was just called")
+	}
+	return m.GetIPv4RoutesFunc(limiters)
+}
+
+// FindMatchingIPv4Routes calls FindMatchingIPv4RoutesFunc.
+func (m *IPAPIMock) FindMatchingIPv4Routes(routes []gotik.IPv4Route, netToMatch *net.IPNet) ([]gotik.IPv4Route, error) {
+	m.record("FindMatchingIPv4Routes", routes, netToMatch)
+	if m.FindMatchingIPv4RoutesFunc == nil {
+		panic("IPAPIMock.FindMatchingIPv4RoutesFunc: method is nil but FindMatchingIPv4Routes was just called")
+	}
+	return m.FindMatchingIPv4RoutesFunc(routes, netToMatch)
+}
+
+// ModifyIPv4Route calls ModifyIPv4RouteFunc.
+func (m *IPAPIMock) ModifyIPv4Route(id string, action string) error {
+	m.record("ModifyIPv4Route", id, action)
+	if m.ModifyIPv4RouteFunc == nil {
+		panic("IPAPIMock.ModifyIPv4RouteFunc: method is nil but ModifyIPv4Route was just called")
+	}
+	return m.ModifyIPv4RouteFunc(id, action)
+}
+
+// GetIPv6Settings calls GetIPv6SettingsFunc.
+func (m *IPAPIMock) GetIPv6Settings() (gotik.IPv6Settings, error) {
+	m.record("GetIPv6Settings")
+	if m.GetIPv6SettingsFunc == nil {
+		panic("IPAPIMock.GetIPv6SettingsFunc: method is nil but GetIPv6Settings was just called")
+	}
+	return m.GetIPv6SettingsFunc()
+}
+
+// GetDhcpv4Networks calls GetDhcpv4NetworksFunc.
+func (m *IPAPIMock) GetDhcpv4Networks() ([]gotik.DHCP4Network, error) {
+	m.record("GetDhcpv4Networks")
+	if m.GetDhcpv4NetworksFunc == nil {
+		panic("IPAPIMock.GetDhcpv4NetworksFunc: method is nil but GetDhcpv4Networks was just called")
+	}
+	return m.GetDhcpv4NetworksFunc()
+}
+
+// AddDhcpv4Network calls AddDhcpv4NetworkFunc.
+func (m *IPAPIMock) AddDhcpv4Network(s gotik.DHCP4Network) (string, error) {
+	m.record("AddDhcpv4Network", s)
+	if m.AddDhcpv4NetworkFunc == nil {
+		panic("IPAPIMock.AddDhcpv4NetworkFunc: method is nil but AddDhcpv4Network was just called")
+	}
+	return m.AddDhcpv4NetworkFunc(s)
+}
+
+// GetDhcpv4Servers calls GetDhcpv4ServersFunc.
+func (m *IPAPIMock) GetDhcpv4Servers() ([]gotik.DHCPv4Server, error) {
+	m.record("GetDhcpv4Servers")
+	if m.GetDhcpv4ServersFunc == nil {
+		panic("IPAPIMock.GetDhcpv4ServersFunc: method is nil but GetDhcpv4Servers was just called")
+	}
+	return m.GetDhcpv4ServersFunc()
+}
+
+// GetDhcp4ServerByName calls GetDhcp4ServerByNameFunc.
+func (m *IPAPIMock) GetDhcp4ServerByName(name string) ([]gotik.DHCPv4Server, error) {
+	m.record("GetDhcp4ServerByName", name)
+	if m.GetDhcp4ServerByNameFunc == nil {
+		panic("IPAPIMock.GetDhcp4ServerByNameFunc: method is nil but GetDhcp4ServerByName was just called")
+	}
+	return m.GetDhcp4ServerByNameFunc(name)
+}
+
+// GetDhcp4ServerByIntf calls GetDhcp4ServerByIntfFunc.
+func (m *IPAPIMock) GetDhcp4ServerByIntf(intf string) ([]gotik.DHCPv4Server, error) {
+	m.record("GetDhcp4ServerByIntf", intf)
+	if m.GetDhcp4ServerByIntfFunc == nil {
+		panic("IPAPIMock.GetDhcp4ServerByIntfFunc: method is nil but GetDhcp4ServerByIntf was just called")
+	}
+	return m.GetDhcp4ServerByIntfFunc(intf)
+}
+
+// AddDhcpv4Server calls AddDhcpv4ServerFunc.
+func (m *IPAPIMock) AddDhcpv4Server(s gotik.DHCPv4Server) (string, error) {
+	m.record("AddDhcpv4Server", s)
+	if m.AddDhcpv4ServerFunc == nil {
+		panic("IPAPIMock.AddDhcpv4ServerFunc: method is nil but AddDhcpv4Server was just called")
+	}
+	return m.AddDhcpv4ServerFunc(s)
+}
+
+// SetDhcpv4ServerDisable calls SetDhcpv4ServerDisableFunc.
+func (m *IPAPIMock) SetDhcpv4ServerDisable(id string, disabled bool) error {
+	m.record("SetDhcpv4ServerDisable", id, disabled)
+	if m.SetDhcpv4ServerDisableFunc == nil {
+		panic("IPAPIMock.SetDhcpv4ServerDisableFunc: method is nil but SetDhcpv4ServerDisable was just called")
+	}
+	return m.SetDhcpv4ServerDisableFunc(id, disabled)
+}
+
+// GetDNS calls GetDNSFunc.
+func (m *IPAPIMock) GetDNS() (gotik.DNS, error) {
+	m.record("GetDNS")
+	if m.GetDNSFunc == nil {
+		panic("IPAPIMock.GetDNSFunc: method is nil but GetDNS was just called")
+	}
+	return m.GetDNSFunc()
+}
+
+// SetDNS calls SetDNSFunc.
+func (m *IPAPIMock) SetDNS(d gotik.DNS) error {
+	m.record("SetDNS", d)
+	if m.SetDNSFunc == nil {
+		panic("IPAPIMock.SetDNSFunc: method is nil but SetDNS was just called")
+	}
+	return m.SetDNSFunc(d)
+}
+
+// FlushDNS calls FlushDNSFunc.
+func (m *IPAPIMock) FlushDNS() error {
+	m.record("FlushDNS")
+	if m.FlushDNSFunc == nil {
+		panic("IPAPIMock.FlushDNSFunc: method is nil but FlushDNS was just called")
+	}
+	return m.FlushDNSFunc()
+}
+
+// GetIPServices calls GetIPServicesFunc.
+func (m *IPAPIMock) GetIPServices() ([]gotik.IPService, error) {
+	m.record("GetIPServices")
+	if m.GetIPServicesFunc == nil {
+		panic("IPAPIMock.GetIPServicesFunc: method is nil but GetIPServices was just called")
+	}
+	return m.GetIPServicesFunc()
+}
+
+// SetIPService calls SetIPServiceFunc.
+func (m *IPAPIMock) SetIPService(id string, disabled bool, port int, address string, cert string, tlsVersion string) error {
+	m.record("SetIPService", id, disabled, port, address, cert, tlsVersion)
+	if m.SetIPServiceFunc == nil {
+		panic("IPAPIMock.SetIPServiceFunc: method is nil but SetIPService was just called")
+	}
+	return m.SetIPServiceFunc(id, disabled, port, address, cert, tlsVersion)
+}
+
+// SetIPServiceDisable calls SetIPServiceDisableFunc.
+func (m *IPAPIMock) SetIPServiceDisable(id string, disabled bool) error {
+	m.record("SetIPServiceDisable", id, disabled)
+	if m.SetIPServiceDisableFunc == nil {
+		panic("IPAPIMock.SetIPServiceDisableFunc: method is nil but SetIPServiceDisable was just called")
+	}
+	return m.SetIPServiceDisableFunc(id, disabled)
+}
+
+// PPPAPIMock is a mock implementation of gotik.PPPAPI.
+type PPPAPIMock struct {
+	// GetPPPSecretsFunc mocks the GetPPPSecrets method.
+	GetPPPSecretsFunc func() ([]gotik.PPPSecret, error)
+
+	// GetPPPSecretByNameFunc mocks the GetPPPSecretByName method.
+	GetPPPSecretByNameFunc func(name string) (gotik.PPPSecret, error)
+
+	// AddPPPSecretFunc mocks the AddPPPSecret method.
+	AddPPPSecretFunc func(secret gotik.PPPSecret) (string, error)
+
+	// UpdatePPPSecretFunc mocks the UpdatePPPSecret method.
+	UpdatePPPSecretFunc func(secret gotik.PPPSecret) (string, error)
+
+	// RemovePPPSecretFunc mocks the RemovePPPSecret method.
+	RemovePPPSecretFunc func(id string) error
+
+	// RemovePPPSecretByNameFunc mocks the RemovePPPSecretByName method.
+	RemovePPPSecretByNameFunc func(name string) error
+
+	// GetPPPActiveConnectionsFunc mocks the GetPPPActiveConnections method.
+	GetPPPActiveConnectionsFunc func() ([]gotik.PPPActive, error)
+
+	// GetPPPActiveConnectionByNameFunc mocks the GetPPPActiveConnectionByName method.
+	GetPPPActiveConnectionByNameFunc func(name string) (gotik.PPPActive, error)
+
+	// GetPPPoEServersFunc mocks the GetPPPoEServers method.
+	GetPPPoEServersFunc func(intf string) ([]gotik.PPPoEServer, error)
+
+	// RemovePPPoEServerFunc mocks the RemovePPPoEServer method.
+	RemovePPPoEServerFunc func(id string) error
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made on the mock so far, in order.
+func (m *PPPAPIMock) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *PPPAPIMock) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+	m.mu.Unlock()
+}
+
+// GetPPPSecrets calls GetPPPSecretsFunc.
+func (m *PPPAPIMock) GetPPPSecrets() ([]gotik.PPPSecret, error) {
+	m.record("GetPPPSecrets")
+	if m.GetPPPSecretsFunc == nil {
+		panic("PPPAPIMock.GetPPPSecretsFunc: method is nil but GetPPPSecrets was just called")
+	}
+	return m.GetPPPSecretsFunc()
+}
+
+// GetPPPSecretByName calls GetPPPSecretByNameFunc.
+func (m *PPPAPIMock) GetPPPSecretByName(name string) (gotik.PPPSecret, error) {
+	m.record("GetPPPSecretByName", name)
+	if m.GetPPPSecretByNameFunc == nil {
+		panic("PPPAPIMock.GetPPPSecretByNameFunc: method is nil but GetPPPSecretByName was just called")
+	}
+	return m.GetPPPSecretByNameFunc(name)
+}
+
+// AddPPPSecret calls AddPPPSecretFunc.
+func (m *PPPAPIMock) AddPPPSecret(secret gotik.PPPSecret) (string, error) {
+	m.record("AddPPPSecret", secret)
+	if m.AddPPPSecretFunc == nil {
+		panic("PPPAPIMock.AddPPPSecretFunc: method is nil but AddPPPSecret was just called")
+	}
+	return m.AddPPPSecretFunc(secret)
+}
+
+// UpdatePPPSecret calls UpdatePPPSecretFunc.
+func (m *PPPAPIMock) UpdatePPPSecret(secret gotik.PPPSecret) (string, error) {
+	m.record("UpdatePPPSecret", secret)
+	if m.UpdatePPPSecretFunc == nil {
+		panic("PPPAPIMock.UpdatePPPSecretFunc: method is nil but UpdatePPPSecret was just called")
+	}
+	return m.UpdatePPPSecretFunc(secret)
+}
+
+// RemovePPPSecret calls RemovePPPSecretFunc.
+func (m *PPPAPIMock) RemovePPPSecret(id string) error {
+	m.record("RemovePPPSecret", id)
+	if m.RemovePPPSecretFunc == nil {
+		panic("PPPAPIMock.RemovePPPSecretFunc: method is nil but RemovePPPSecret was just called")
+	}
+	return m.RemovePPPSecretFunc(id)
+}
+
+// RemovePPPSecretByName calls RemovePPPSecretByNameFunc.
+func (m *PPPAPIMock) RemovePPPSecretByName(name string) error {
+	m.record("RemovePPPSecretByName", name)
+	if m.RemovePPPSecretByNameFunc == nil {
+		panic("PPPAPIMock.RemovePPPSecretByNameFunc: method is nil but RemovePPPSecretByName was just called")
+	}
+	return m.RemovePPPSecretByNameFunc(name)
+}
+
+// GetPPPActiveConnections calls GetPPPActiveConnectionsFunc.
+func (m *PPPAPIMock) GetPPPActiveConnections() ([]gotik.PPPActive, error) {
+	m.record("GetPPPActiveConnections")
+	if m.GetPPPActiveConnectionsFunc == nil {
+		panic("PPPAPIMock.GetPPPActiveConnectionsFunc: method is nil but GetPPPActiveConnections was just called")
+	}
+	return m.GetPPPActiveConnectionsFunc()
+}
+
+// GetPPPActiveConnectionByName calls GetPPPActiveConnectionByNameFunc.
+func (m *PPPAPIMock) GetPPPActiveConnectionByName(name string) (gotik.PPPActive, error) {
+	m.record("GetPPPActiveConnectionByName", name)
+	if m.GetPPPActiveConnectionByNameFunc == nil {
+		panic("PPPAPIMock.GetPPPActiveConnectionByNameFunc: method is nil but GetPPPActiveConnectionByName was just called")
+	}
+	return m.GetPPPActiveConnectionByNameFunc(name)
+}
+
+// GetPPPoEServers calls GetPPPoEServersFunc.
+func (m *PPPAPIMock) GetPPPoEServers(intf string) ([]gotik.PPPoEServer, error) {
+	m.record("GetPPPoEServers", intf)
+	if m.GetPPPoEServersFunc == nil {
+		panic("PPPAPIMock.GetPPPoEServersFunc: method is nil but GetPPPoEServers was just called")
+	}
+	return m.GetPPPoEServersFunc(intf)
+}
+
+// RemovePPPoEServer calls RemovePPPoEServerFunc.
+func (m *PPPAPIMock) RemovePPPoEServer(id string) error {
+	m.record("RemovePPPoEServer", id)
+	if m.RemovePPPoEServerFunc == nil {
+		panic("PPPAPIMock.RemovePPPoEServerFunc: method is nil but RemovePPPoEServer was just called")
+	}
+	return m.RemovePPPoEServerFunc(id)
+}
+
+// QueueAPIMock is a mock implementation of gotik.QueueAPI.
+type QueueAPIMock struct {
+	// GetQueueTreeByNameFunc mocks the GetQueueTreeByName method.
+	GetQueueTreeByNameFunc func(name string) (gotik.QueueTree, error)
+
+	// GetQueueTreeFunc mocks the GetQueueTree method.
+	GetQueueTreeFunc func(parent string) ([]gotik.QueueTree, error)
+
+	// GetQueueTreeAllFunc mocks the GetQueueTreeAll method.
+	GetQueueTreeAllFunc func() ([]gotik.QueueTree, error)
+
+	// AddQueueTreeFunc mocks the AddQueueTree method.
+	AddQueueTreeFunc func(queue *gotik.QueueTree) error
+
+	// AddQueueTreeSingleFunc mocks the AddQueueTreeSingle method.
+	AddQueueTreeSingleFunc func(queue gotik.QueueTree) (string, error)
+
+	// RemoveQueueTreeFunc mocks the RemoveQueueTree method.
+	RemoveQueueTreeFunc func(queue gotik.QueueTree, removeChildren bool) error
+
+	// RemoveQueueTreeByNameFunc mocks the RemoveQueueTreeByName method.
+	RemoveQueueTreeByNameFunc func(name string) error
+
+	// GetSimpleQueuesFunc mocks the GetSimpleQueues method.
+	GetSimpleQueuesFunc func(target string) ([]gotik.SimpleQueue, error)
+
+	// RemoveSimpleQueueFunc mocks the RemoveSimpleQueue method.
+	RemoveSimpleQueueFunc func(ID string) error
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made on the mock so far, in order.
+func (m *QueueAPIMock) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *QueueAPIMock) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+	m.mu.Unlock()
+}
+
+// GetQueueTreeByName calls GetQueueTreeByNameFunc.
+func (m *QueueAPIMock) GetQueueTreeByName(name string) (gotik.QueueTree, error) {
+	m.record("GetQueueTreeByName", name)
+	if m.GetQueueTreeByNameFunc == nil {
+		panic("QueueAPIMock.GetQueueTreeByNameFunc: method is nil but GetQueueTreeByName was just called")
+	}
+	return m.GetQueueTreeByNameFunc(name)
+}
+
+// GetQueueTree calls GetQueueTreeFunc.
+func (m *QueueAPIMock) GetQueueTree(parent string) ([]gotik.QueueTree, error) {
+	m.record("GetQueueTree", parent)
+	if m.GetQueueTreeFunc == nil {
+		panic("QueueAPIMock.GetQueueTreeFunc: method is nil but GetQueueTree was just called")
+	}
+	return m.GetQueueTreeFunc(parent)
+}
+
+// GetQueueTreeAll calls GetQueueTreeAllFunc.
+func (m *QueueAPIMock) GetQueueTreeAll() ([]gotik.QueueTree, error) {
+	m.record("GetQueueTreeAll")
+	if m.GetQueueTreeAllFunc == nil {
+		panic("QueueAPIMock.GetQueueTreeAllFunc: method is nil but GetQueueTreeAll was just called")
+	}
+	return m.GetQueueTreeAllFunc()
+}
+
+// AddQueueTree calls AddQueueTreeFunc.
+func (m *QueueAPIMock) AddQueueTree(queue *gotik.QueueTree) error {
+	m.record("AddQueueTree", queue)
+	if m.AddQueueTreeFunc == nil {
+		panic("QueueAPIMock.AddQueueTreeFunc: method is nil but AddQueueTree was just called")
+	}
+	return m.AddQueueTreeFunc(queue)
+}
+
+// AddQueueTreeSingle calls AddQueueTreeSingleFunc.
+func (m *QueueAPIMock) AddQueueTreeSingle(queue gotik.QueueTree) (string, error) {
+	m.record("AddQueueTreeSingle", queue)
+	if m.AddQueueTreeSingleFunc == nil {
+		panic("QueueAPIMock.AddQueueTreeSingleFunc: method is nil but AddQueueTreeSingle was just called")
+	}
+	return m.AddQueueTreeSingleFunc(queue)
+}
+
+// RemoveQueueTree calls RemoveQueueTreeFunc.
+func (m *QueueAPIMock) RemoveQueueTree(queue gotik.QueueTree, removeChildren bool) error {
+	m.record("RemoveQueueTree", queue, removeChildren)
+	if m.RemoveQueueTreeFunc == nil {
+		panic("QueueAPIMock.RemoveQueueTreeFunc: method is nil but RemoveQueueTree was just called")
+	}
+	return m.RemoveQueueTreeFunc(queue, removeChildren)
+}
+
+// RemoveQueueTreeByName calls RemoveQueueTreeByNameFunc.
+func (m *QueueAPIMock) RemoveQueueTreeByName(name string) error {
+	m.record("RemoveQueueTreeByName", name)
+	if m.RemoveQueueTreeByNameFunc == nil {
+		panic("QueueAPIMock.RemoveQueueTreeByNameFunc: method is nil but RemoveQueueTreeByName was just called")
+	}
+	return m.RemoveQueueTreeByNameFunc(name)
+}
+
+// GetSimpleQueues calls GetSimpleQueuesFunc.
+func (m *QueueAPIMock) GetSimpleQueues(target string) ([]gotik.SimpleQueue, error) {
+	m.record("GetSimpleQueues", target)
+	if m.GetSimpleQueuesFunc == nil {
+		panic("QueueAPIMock.GetSimpleQueuesFunc: method is nil but GetSimpleQueues was just called")
+	}
+	return m.GetSimpleQueuesFunc(target)
+}
+
+// RemoveSimpleQueue calls RemoveSimpleQueueFunc.
+func (m *QueueAPIMock) RemoveSimpleQueue(ID string) error {
+	m.record("RemoveSimpleQueue", ID)
+	if m.RemoveSimpleQueueFunc == nil {
+		panic("QueueAPIMock.RemoveSimpleQueueFunc: method is nil but RemoveSimpleQueue was just called")
+	}
+	return m.RemoveSimpleQueueFunc(ID)
+}
+
+// RoutingAPIMock is a mock implementation of gotik.RoutingAPI.
+type RoutingAPIMock struct {
+	// GetOspf2LsaTableFunc mocks the GetOspf2LsaTable method.
+	GetOspf2LsaTableFunc func() ([]gotik.OSPF2LSA, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made on the mock so far, in order.
+func (m *RoutingAPIMock) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *RoutingAPIMock) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+	m.mu.Unlock()
+}
+
+// GetOspf2LsaTable calls GetOspf2LsaTableFunc.
+func (m *RoutingAPIMock) GetOspf2LsaTable() ([]gotik.OSPF2LSA, error) {
+	m.record("GetOspf2LsaTable")
+	if m.GetOspf2LsaTableFunc == nil {
+		panic("RoutingAPIMock.GetOspf2LsaTableFunc: method is nil but GetOspf2LsaTable was just called")
+	}
+	return m.GetOspf2LsaTableFunc()
+}
+
+// SystemAPIMock is a mock implementation of gotik.SystemAPI.
+type SystemAPIMock struct {
+	// GetSystemResourcesFunc mocks the GetSystemResources method.
+	GetSystemResourcesFunc func() (gotik.Resources, error)
+
+	// GetSystemRouterboardFunc mocks the GetSystemRouterboard method.
+	GetSystemRouterboardFunc func() (gotik.Routerboard, error)
+
+	// GetSystemIdFunc mocks the GetSystemId method.
+	GetSystemIdFunc func() (string, error)
+
+	// GetSystemLicenseFunc mocks the GetSystemLicense method.
+	GetSystemLicenseFunc func() (gotik.License, error)
+
+	// CreateExportFunc mocks the CreateExport method.
+	CreateExportFunc func(targetname string, minFreeSpace int) error
+
+	// ExportConfigFunc mocks the ExportConfig method.
+	ExportConfigFunc func(base string, filename string, hideSensitive bool) error
+
+	// ExportConfigToWriterFunc mocks the ExportConfigToWriter method.
+	ExportConfigToWriterFunc func(base string, w io.Writer, hideSensitive bool) error
+
+	// FetchFunc mocks the Fetch method.
+	FetchFunc func(filename string, hideSensitive bool) error
+
+	// GetPackagesFunc mocks the GetPackages method.
+	GetPackagesFunc func() ([]gotik.Package, error)
+
+	// IsPackageEnabledFunc mocks the IsPackageEnabled method.
+	IsPackageEnabledFunc func(name string) (bool, error)
+
+	// GetUpdateInfoFunc mocks the GetUpdateInfo method.
+	GetUpdateInfoFunc func() (gotik.PackageUpdate, error)
+
+	// CheckForUpdatesFunc mocks the CheckForUpdates method.
+	CheckForUpdatesFunc func() (gotik.PackageUpdate, error)
+
+	// SetUpdateChannelFunc mocks the SetUpdateChannel method.
+	SetUpdateChannelFunc func(channel string) error
+
+	// DownloadUpdatesFunc mocks the DownloadUpdates method.
+	DownloadUpdatesFunc func() (gotik.PackageUpdate, error)
+
+	// InstallUpdatesFunc mocks the InstallUpdates method.
+	InstallUpdatesFunc func() (gotik.PackageUpdate, error)
+
+	// GetUsersFunc mocks the GetUsers method.
+	GetUsersFunc func() ([]gotik.User, error)
+
+	// GetUserByNameFunc mocks the GetUserByName method.
+	GetUserByNameFunc func(name string) (gotik.User, error)
+
+	// AddUserFunc mocks the AddUser method.
+	AddUserFunc func(user gotik.User) (string, error)
+
+	// UpdateUserFunc mocks the UpdateUser method.
+	UpdateUserFunc func(user gotik.User) (string, error)
+
+	// UpdateUserPasswordByIDFunc mocks the UpdateUserPasswordByID method.
+	UpdateUserPasswordByIDFunc func(ID string, password string) (string, error)
+
+	// UpdateUserPasswordByNameFunc mocks the UpdateUserPasswordByName method.
+	UpdateUserPasswordByNameFunc func(username string, password string) (string, error)
+
+	// RemoveUserFunc mocks the RemoveUser method.
+	RemoveUserFunc func(id string) error
+
+	// RemoveUserByNameFunc mocks the RemoveUserByName method.
+	RemoveUserByNameFunc func(name string) error
+
+	// GetGroupsFunc mocks the GetGroups method.
+	GetGroupsFunc func() ([]gotik.Group, error)
+
+	// GetGroupByNameFunc mocks the GetGroupByName method.
+	GetGroupByNameFunc func(name string) (gotik.Group, error)
+
+	// AddGroupFunc mocks the AddGroup method.
+	AddGroupFunc func(g gotik.Group) (string, error)
+
+	// UpdateGroupFunc mocks the UpdateGroup method.
+	UpdateGroupFunc func(g gotik.Group) (string, error)
+
+	// RemoveGroupFunc mocks the RemoveGroup method.
+	RemoveGroupFunc func(id string) error
+
+	// RemoveGroupByNameFunc mocks the RemoveGroupByName method.
+	RemoveGroupByNameFunc func(name string) error
+
+	// GetScriptsFunc mocks the GetScripts method.
+	GetScriptsFunc func() ([]gotik.Script, error)
+
+	// AddScriptFunc mocks the AddScript method.
+	AddScriptFunc func(s gotik.Script) (string, error)
+
+	// UpdateScriptFunc mocks the UpdateScript method.
+	UpdateScriptFunc func(s gotik.Script) (string, error)
+
+	// RemoveScriptFunc mocks the RemoveScript method.
+	RemoveScriptFunc func(id string) error
+
+	// GetSchedulerFunc mocks the GetScheduler method.
+	GetSchedulerFunc func() ([]gotik.Schedule, error)
+
+	// AddScheduleFunc mocks the AddSchedule method.
+	AddScheduleFunc func(s gotik.Schedule) (string, error)
+
+	// UpdateScheduleFunc mocks the UpdateSchedule method.
+	UpdateScheduleFunc func(s gotik.Schedule) (string, error)
+
+	// RemoveScheduleFunc mocks the RemoveSchedule method.
+	RemoveScheduleFunc func(id string) error
+
+	// GetAllFilesFunc mocks the GetAllFiles method.
+	GetAllFilesFunc func() ([]gotik.File, error)
+
+	// AddFileFunc mocks the AddFile method.
+	AddFileFunc func(name string, contents string) error
+
+	// RemoveFileByNameFunc mocks the RemoveFileByName method.
+	RemoveFileByNameFunc func(name string) error
+
+	// RemoveFileByIDFunc mocks the RemoveFileByID method.
+	RemoveFileByIDFunc func(id string) error
+
+	// ReadFileFunc mocks the ReadFile method.
+	ReadFileFunc func(name string) ([]byte, error)
+
+	// DownloadFileFunc mocks the DownloadFile method.
+	DownloadFileFunc func(name string, w io.Writer) error
+
+	// UploadFileFunc mocks the UploadFile method.
+	UploadFileFunc func(name string, r io.Reader) error
+
+	// ImportScriptFunc mocks the ImportScript method.
+	ImportScriptFunc func(name string, opts gotik.ImportOptions) (gotik.ImportResult, error)
+
+	// ImportStringFunc mocks the ImportString method.
+	ImportStringFunc func(script string, opts gotik.ImportOptions) (gotik.ImportResult, error)
+
+	// GetCertificatesFunc mocks the GetCertificates method.
+	GetCertificatesFunc func() ([]gotik.Certificate, error)
+
+	// CertificateImportFunc mocks the CertificateImport method.
+	CertificateImportFunc func(name string, filename string, passphrase string) (gotik.CertImportResults, error)
+
+	// SetCertificateNameFunc mocks the SetCertificateName method.
+	SetCertificateNameFunc func(id string, name string) error
+
+	// RemoveCertificateFunc mocks the RemoveCertificate method.
+	RemoveCertificateFunc func(id string) error
+
+	// GetNTPClientFunc mocks the GetNTPClient method.
+	GetNTPClientFunc func() (any, error)
+
+	// SetNTPClientFunc mocks the SetNTPClient method.
+	SetNTPClientFunc func(ntp any) error
+
+	// GetSNMPFunc mocks the GetSNMP method.
+	GetSNMPFunc func() (gotik.SNMP, error)
+
+	// SetSNMPFunc mocks the SetSNMP method.
+	SetSNMPFunc func(s gotik.SNMP) error
+
+	// GetSNMPCommunitiesFunc mocks the GetSNMPCommunities method.
+	GetSNMPCommunitiesFunc func() ([]gotik.SNMPCommunity, error)
+
+	// AddSNMPCommunityFunc mocks the AddSNMPCommunity method.
+	AddSNMPCommunityFunc func(community gotik.SNMPCommunity) (string, error)
+
+	// UpdateSNMPCommunityFunc mocks the UpdateSNMPCommunity method.
+	UpdateSNMPCommunityFunc func(community gotik.SNMPCommunity) (string, error)
+
+	// RemoveSNMPCommunityFunc mocks the RemoveSNMPCommunity method.
+	RemoveSNMPCommunityFunc func(id string) error
+
+	// GetAAAFunc mocks the GetAAA method.
+	GetAAAFunc func() (gotik.AAA, error)
+
+	// SetAAAFunc mocks the SetAAA method.
+	SetAAAFunc func(a gotik.AAA) (string, error)
+
+	// GetRadiusFunc mocks the GetRadius method.
+	GetRadiusFunc func() ([]gotik.RadiusServer, error)
+
+	// AddRadiusFunc mocks the AddRadius method.
+	AddRadiusFunc func(r gotik.RadiusServer, placeBefore string) (string, error)
+
+	// RemoveRadiusFunc mocks the RemoveRadius method.
+	RemoveRadiusFunc func(id string) error
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made on the mock so far, in order.
+func (m *SystemAPIMock) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *SystemAPIMock) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+	m.mu.Unlock()
+}
+
+// GetSystemResources calls GetSystemResourcesFunc.
+func (m *SystemAPIMock) GetSystemResources() (gotik.Resources, error) {
+	m.record("GetSystemResources")
+	if m.GetSystemResourcesFunc == nil {
+		panic("SystemAPIMock.GetSystemResourcesFunc: method is nil but GetSystemResources was just called")
+	}
+	return m.GetSystemResourcesFunc()
+}
+
+// GetSystemRouterboard calls GetSystemRouterboardFunc.
+func (m *SystemAPIMock) GetSystemRouterboard() (gotik.Routerboard, error) {
+	m.record("GetSystemRouterboard")
+	if m.GetSystemRouterboardFunc == nil {
+		panic("SystemAPIMock.GetSystemRouterboardFunc: method is nil but GetSystemRouterboard was just called")
+	}
+	return m.GetSystemRouterboardFunc()
+}
+
+// GetSystemId calls GetSystemIdFunc.
+func (m *SystemAPIMock) GetSystemId() (string, error) {
+	m.record("GetSystemId")
+	if m.GetSystemIdFunc == nil {
+		panic("SystemAPIMock.GetSystemIdFunc: method is nil but GetSystemId was just called")
+	}
+	return m.GetSystemIdFunc()
+}
+
+// GetSystemLicense calls GetSystemLicenseFunc.
+func (m *SystemAPIMock) GetSystemLicense() (gotik.License, error) {
+	m.record("GetSystemLicense")
+	if m.GetSystemLicenseFunc == nil {
+		panic("SystemAPIMock.GetSystemLicenseFunc: method is nil but GetSystemLicense was just called")
+	}
+	return m.GetSystemLicenseFunc()
+}
+
+// CreateExport calls CreateExportFunc.
+func (m *SystemAPIMock) CreateExport(targetname string, minFreeSpace int) error {
+	m.record("CreateExport", targetname, minFreeSpace)
+	if m.CreateExportFunc == nil {
+		panic("SystemAPIMock.CreateExportFunc: method is nil but CreateExport was just called")
+	}
+	return m.CreateExportFunc(targetname, minFreeSpace)
+}
+
+// ExportConfig calls ExportConfigFunc.
+func (m *SystemAPIMock) ExportConfig(base string, filename string, hideSensitive bool) error {
+	m.record("ExportConfig", base, filename, hideSensitive)
+	if m.ExportConfigFunc == nil {
+		panic("SystemAPIMock.ExportConfigFunc: method is nil but ExportConfig was just called")
+	}
+	return m.ExportConfigFunc(base, filename, hideSensitive)
+}
+
+// ExportConfigToWriter calls ExportConfigToWriterFunc.
+func (m *SystemAPIMock) ExportConfigToWriter(base string, w io.Writer, hideSensitive bool) error {
+	m.record("ExportConfigToWriter", base, w, hideSensitive)
+	if m.ExportConfigToWriterFunc == nil {
+		panic("SystemAPIMock.ExportConfigToWriterFunc: method is nil but ExportConfigToWriter was just called")
+	}
+	return m.ExportConfigToWriterFunc(base, w, hideSensitive)
+}
+
+// Fetch calls FetchFunc.
+func (m *SystemAPIMock) Fetch(filename string, hideSensitive bool) error {
+	m.record("Fetch", filename, hideSensitive)
+	if m.FetchFunc == nil {
+		panic("SystemAPIMock.FetchFunc: method is nil but Fetch was just called")
+	}
+	return m.FetchFunc(filename, hideSensitive)
+}
+
+// GetPackages calls GetPackagesFunc.
+func (m *SystemAPIMock) GetPackages() ([]gotik.Package, error) {
+	m.record("GetPackages")
+	if m.GetPackagesFunc == nil {
+		panic("SystemAPIMock.GetPackagesFunc: method is nil but GetPackages was just called")
+	}
+	return m.GetPackagesFunc()
+}
+
+// IsPackageEnabled calls IsPackageEnabledFunc.
+func (m *SystemAPIMock) IsPackageEnabled(name string) (bool, error) {
+	m.record("IsPackageEnabled", name)
+	if m.IsPackageEnabledFunc == nil {
+		panic("SystemAPIMock.IsPackageEnabledFunc: method is nil but IsPackageEnabled was just called")
+	}
+	return m.IsPackageEnabledFunc(name)
+}
+
+// GetUpdateInfo calls GetUpdateInfoFunc.
+func (m *SystemAPIMock) GetUpdateInfo() (gotik.PackageUpdate, error) {
+	m.record("GetUpdateInfo")
+	if m.GetUpdateInfoFunc == nil {
+		panic("SystemAPIMock.GetUpdateInfoFunc: method is nil but GetUpdateInfo was just called")
+	}
+	return m.GetUpdateInfoFunc()
+}
+
+// CheckForUpdates calls CheckForUpdatesFunc.
+func (m *SystemAPIMock) CheckForUpdates() (gotik.PackageUpdate, error) {
+	m.record("CheckForUpdates")
+	if m.CheckForUpdatesFunc == nil {
+		panic("SystemAPIMock.CheckForUpdatesFunc: method is nil but CheckForUpdates was just called")
+	}
+	return m.CheckForUpdatesFunc()
+}
+
+// SetUpdateChannel calls SetUpdateChannelFunc.
+func (m *SystemAPIMock) SetUpdateChannel(channel string) error {
+	m.record("SetUpdateChannel", channel)
+	if m.SetUpdateChannelFunc == nil {
+		panic("SystemAPIMock.SetUpdateChannelFunc: method is nil but SetUpdateChannel was just called")
+	}
+	return m.SetUpdateChannelFunc(channel)
+}
+
+// DownloadUpdates calls DownloadUpdatesFunc.
+func (m *SystemAPIMock) DownloadUpdates() (gotik.PackageUpdate, error) {
+	m.record("DownloadUpdates")
+	if m.DownloadUpdatesFunc == nil {
+		panic("SystemAPIMock.DownloadUpdatesFunc: method is nil but DownloadUpdates was just called")
+	}
+	return m.DownloadUpdatesFunc()
+}
+
+// InstallUpdates calls InstallUpdatesFunc.
+func (m *SystemAPIMock) InstallUpdates() (gotik.PackageUpdate, error) {
+	m.record("InstallUpdates")
+	if m.InstallUpdatesFunc == nil {
+		panic("SystemAPIMock.InstallUpdatesFunc: method is nil but InstallUpdates was just called")
+	}
+	return m.InstallUpdatesFunc()
+}
+
+// GetUsers calls GetUsersFunc.
+func (m *SystemAPIMock) GetUsers() ([]gotik.User, error) {
+	m.record("GetUsers")
+	if m.GetUsersFunc == nil {
+		panic("SystemAPIMock.GetUsersFunc: method is nil but GetUsers was just called")
+	}
+	return m.GetUsersFunc()
+}
+
+// GetUserByName calls GetUserByNameFunc.
+func (m *SystemAPIMock) GetUserByName(name string) (gotik.User, error) {
+	m.record("GetUserByName", name)
+	if m.GetUserByNameFunc == nil {
+		panic("SystemAPIMock.GetUserByNameFunc: method is nil but GetUserByName was just called")
+	}
+	return m.GetUserByNameFunc(name)
+}
+
+// AddUser calls AddUserFunc.
+func (m *SystemAPIMock) AddUser(user gotik.User) (string, error) {
+	m.record("AddUser", user)
+	if m.AddUserFunc == nil {
+		panic("SystemAPIMock.AddUserFunc: method is nil but AddUser was just called")
+	}
+	return m.AddUserFunc(user)
+}
+
+// UpdateUser calls UpdateUserFunc.
+func (m *SystemAPIMock) UpdateUser(user gotik.User) (string, error) {
+	m.record("UpdateUser", user)
+	if m.UpdateUserFunc == nil {
+		panic("SystemAPIMock.UpdateUserFunc: method is nil but UpdateUser was just called")
+	}
+	return m.UpdateUserFunc(user)
+}
+
+// UpdateUserPasswordByID calls UpdateUserPasswordByIDFunc.
+func (m *SystemAPIMock) UpdateUserPasswordByID(ID string, password string) (string, error) {
+	m.record("UpdateUserPasswordByID", ID, password)
+	if m.UpdateUserPasswordByIDFunc == nil {
+		panic("SystemAPIMock.UpdateUserPasswordByIDFunc: method is nil but UpdateUserPasswordByID was just called")
+	}
+	return m.UpdateUserPasswordByIDFunc(ID, password)
+}
+
+// UpdateUserPasswordByName calls UpdateUserPasswordByNameFunc.
+func (m *SystemAPIMock) UpdateUserPasswordByName(username string, password string) (string, error) {
+	m.record("UpdateUserPasswordByName", username, password)
+	if m.UpdateUserPasswordByNameFunc == nil {
+		panic("SystemAPIMock.UpdateUserPasswordByNameFunc: method is nil but UpdateUserPasswordByName was just called")
+	}
+	return m.UpdateUserPasswordByNameFunc(username, password)
+}
+
+// RemoveUser calls RemoveUserFunc.
+func (m *SystemAPIMock) RemoveUser(id string) error {
+	m.record("RemoveUser", id)
+	if m.RemoveUserFunc == nil {
+		panic("SystemAPIMock.RemoveUserFunc: method is nil but RemoveUser was just called")
+	}
+	return m.RemoveUserFunc(id)
+}
+
+// RemoveUserByName calls RemoveUserByNameFunc.
+func (m *SystemAPIMock) RemoveUserByName(name string) error {
+	m.record("RemoveUserByName", name)
+	if m.RemoveUserByNameFunc == nil {
+		panic("SystemAPIMock.RemoveUserByNameFunc: method is nil but RemoveUserByName was just called")
+	}
+	return m.RemoveUserByNameFunc(name)
+}
+
+// GetGroups calls GetGroupsFunc.
+func (m *SystemAPIMock) GetGroups() ([]gotik.Group, error) {
+	m.record("GetGroups")
+	if m.GetGroupsFunc == nil {
+		panic("SystemAPIMock.GetGroupsFunc: method is nil but GetGroups was just called")
+	}
+	return m.GetGroupsFunc()
+}
+
+// GetGroupByName calls GetGroupByNameFunc.
+func (m *SystemAPIMock) GetGroupByName(name string) (gotik.Group, error) {
+	m.record("GetGroupByName", name)
+	if m.GetGroupByNameFunc == nil {
+		panic("SystemAPIMock.GetGroupByNameFunc: method is nil but GetGroupByName was just called")
+	}
+	return m.GetGroupByNameFunc(name)
+}
+
+// AddGroup calls AddGroupFunc.
+func (m *SystemAPIMock) AddGroup(g gotik.Group) (string, error) {
+	m.record("AddGroup", g)
+	if m.AddGroupFunc == nil {
+		panic("SystemAPIMock.AddGroupFunc: method is nil but AddGroup was just called")
+	}
+	return m.AddGroupFunc(g)
+}
+
+// UpdateGroup calls UpdateGroupFunc.
+func (m *SystemAPIMock) UpdateGroup(g gotik.Group) (string, error) {
+	m.record("UpdateGroup", g)
+	if m.UpdateGroupFunc == nil {
+		panic("SystemAPIMock.UpdateGroupFunc: method is nil but UpdateGroup was just called")
+	}
+	return m.UpdateGroupFunc(g)
+}
+
+// RemoveGroup calls RemoveGroupFunc.
+func (m *SystemAPIMock) RemoveGroup(id string) error {
+	m.record("RemoveGroup", id)
+	if m.RemoveGroupFunc == nil {
+		panic("SystemAPIMock.RemoveGroupFunc: method is nil but RemoveGroup was just called")
+	}
+	return m.RemoveGroupFunc(id)
+}
+
+// RemoveGroupByName calls RemoveGroupByNameFunc.
+func (m *SystemAPIMock) RemoveGroupByName(name string) error {
+	m.record("RemoveGroupByName", name)
+	if m.RemoveGroupByNameFunc == nil {
+		panic("SystemAPIMock.RemoveGroupByNameFunc: method is nil but RemoveGroupByName was just called")
+	}
+	return m.RemoveGroupByNameFunc(name)
+}
+
+// GetScripts calls GetScriptsFunc.
+func (m *SystemAPIMock) GetScripts() ([]gotik.Script, error) {
+	m.record("GetScripts")
+	if m.GetScriptsFunc == nil {
+		panic("SystemAPIMock.GetScriptsFunc: method is nil but GetScripts was just called")
+	}
+	return m.GetScriptsFunc()
+}
+
+// AddScript calls AddScriptFunc.
+func (m *SystemAPIMock) AddScript(s gotik.Script) (string, error) {
+	m.record("AddScript", s)
+	if m.AddScriptFunc == nil {
+		panic("SystemAPIMock.AddScriptFunc: method is nil but AddScript was just called")
+	}
+	return m.AddScriptFunc(s)
+}
+
+// UpdateScript calls UpdateScriptFunc.
+func (m *SystemAPIMock) UpdateScript(s gotik.Script) (string, error) {
+	m.record("UpdateScript", s)
+	if m.UpdateScriptFunc == nil {
+		panic("SystemAPIMock.UpdateScriptFunc: method is nil but UpdateScript was just called")
+	}
+	return m.UpdateScriptFunc(s)
+}
+
+// RemoveScript calls RemoveScriptFunc.
+func (m *SystemAPIMock) RemoveScript(id string) error {
+	m.record("RemoveScript", id)
+	if m.RemoveScriptFunc == nil {
+		panic("SystemAPIMock.RemoveScriptFunc: method is nil but RemoveScript was just called")
+	}
+	return m.RemoveScriptFunc(id)
+}
+
+// GetScheduler calls GetSchedulerFunc.
+func (m *SystemAPIMock) GetScheduler() ([]gotik.Schedule, error) {
+	m.record("GetScheduler")
+	if m.GetSchedulerFunc == nil {
+		panic("SystemAPIMock.GetSchedulerFunc: method is nil but GetScheduler was just called")
+	}
+	return m.GetSchedulerFunc()
+}
+
+// AddSchedule calls AddScheduleFunc.
+func (m *SystemAPIMock) AddSchedule(s gotik.Schedule) (string, error) {
+	m.record("AddSchedule", s)
+	if m.AddScheduleFunc == nil {
+		panic("SystemAPIMock.AddScheduleFunc: method is nil but AddSchedule was just called")
+	}
+	return m.AddScheduleFunc(s)
+}
+
+// UpdateSchedule calls UpdateScheduleFunc.
+func (m *SystemAPIMock) UpdateSchedule(s gotik.Schedule) (string, error) {
+	m.record("UpdateSchedule", s)
+	if m.UpdateScheduleFunc == nil {
+		panic("SystemAPIMock.UpdateScheduleFunc: method is nil but UpdateSchedule was just called")
+	}
+	return m.UpdateScheduleFunc(s)
+}
+
+// RemoveSchedule calls RemoveScheduleFunc.
+func (m *SystemAPIMock) RemoveSchedule(id string) error {
+	m.record("RemoveSchedule", id)
+	if m.RemoveScheduleFunc == nil {
+		panic("SystemAPIMock.RemoveScheduleFunc: method is nil but RemoveSchedule was just called")
+	}
+	return m.RemoveScheduleFunc(id)
+}
+
+// GetAllFiles calls GetAllFilesFunc.
+func (m *SystemAPIMock) GetAllFiles() ([]gotik.File, error) {
+	m.record("GetAllFiles")
+	if m.GetAllFilesFunc == nil {
+		panic("SystemAPIMock.GetAllFilesFunc: method is nil but GetAllFiles was just called")
+	}
+	return m.GetAllFilesFunc()
+}
+
+// AddFile calls AddFileFunc.
+func (m *SystemAPIMock) AddFile(name string, contents string) error {
+	m.record("AddFile", name, contents)
+	if m.AddFileFunc == nil {
+		panic("SystemAPIMock.AddFileFunc: method is nil but AddFile was just called")
+	}
+	return m.AddFileFunc(name, contents)
+}
+
+// RemoveFileByName calls RemoveFileByNameFunc.
+func (m *SystemAPIMock) RemoveFileByName(name string) error {
+	m.record("RemoveFileByName", name)
+	if m.RemoveFileByNameFunc == nil {
+		panic("SystemAPIMock.RemoveFileByNameFunc: method is nil but RemoveFileByName was just called")
+	}
+	return m.RemoveFileByNameFunc(name)
+}
+
+// RemoveFileByID calls RemoveFileByIDFunc.
+func (m *SystemAPIMock) RemoveFileByID(id string) error {
+	m.record("RemoveFileByID", id)
+	if m.RemoveFileByIDFunc == nil {
+		panic("SystemAPIMock.RemoveFileByIDFunc: method is nil but RemoveFileByID was just called")
+	}
+	return m.RemoveFileByIDFunc(id)
+}
+
+// ReadFile calls ReadFileFunc.
+func (m *SystemAPIMock) ReadFile(name string) ([]byte, error) {
+	m.record("ReadFile", name)
+	if m.ReadFileFunc == nil {
+		panic("SystemAPIMock.ReadFileFunc: method is nil but ReadFile was just called")
+	}
+	return m.ReadFileFunc(name)
+}
+
+// DownloadFile calls DownloadFileFunc.
+func (m *SystemAPIMock) DownloadFile(name string, w io.Writer) error {
+	m.record("DownloadFile", name, w)
+	if m.DownloadFileFunc == nil {
+		panic("SystemAPIMock.DownloadFileFunc: method is nil but DownloadFile was just called")
+	}
+	return m.DownloadFileFunc(name, w)
+}
+
+// UploadFile calls UploadFileFunc.
+func (m *SystemAPIMock) UploadFile(name string, r io.Reader) error {
+	m.record("UploadFile", name, r)
+	if m.UploadFileFunc == nil {
+		panic("SystemAPIMock.UploadFileFunc: method is nil but UploadFile was just called")
+	}
+	return m.UploadFileFunc(name, r)
+}
+
+// ImportScript calls ImportScriptFunc.
+func (m *SystemAPIMock) ImportScript(name string, opts gotik.ImportOptions) (gotik.ImportResult, error) {
+	m.record("ImportScript", name, opts)
+	if m.ImportScriptFunc == nil {
+		panic("SystemAPIMock.ImportScriptFunc: method is nil but ImportScript was just called")
+	}
+	return m.ImportScriptFunc(name, opts)
+}
+
+// ImportString calls ImportStringFunc.
+func (m *SystemAPIMock) ImportString(script string, opts gotik.ImportOptions) (gotik.ImportResult, error) {
+	m.record("ImportString", script, opts)
+	if m.ImportStringFunc == nil {
+		panic("SystemAPIMock.ImportStringFunc: method is nil but ImportString was just called")
+	}
+	return m.ImportStringFunc(script, opts)
+}
+
+// GetCertificates calls GetCertificatesFunc.
+func (m *SystemAPIMock) GetCertificates() ([]gotik.Certificate, error) {
+	m.record("GetCertificates")
+	if m.GetCertificatesFunc == nil {
+		panic("SystemAPIMock.GetCertificatesFunc: method is nil but GetCertificates was just called")
+	}
+	return m.GetCertificatesFunc()
+}
+
+// CertificateImport calls CertificateImportFunc.
+func (m *SystemAPIMock) CertificateImport(name string, filename string, passphrase string) (gotik.CertImportResults, error) {
+	m.record("CertificateImport", name, filename, passphrase)
+	if m.CertificateImportFunc == nil {
+		panic("SystemAPIMock.CertificateImportFunc: method is nil but CertificateImport was just called")
+	}
+	return m.CertificateImportFunc(name, filename, passphrase)
+}
+
+// SetCertificateName calls SetCertificateNameFunc.
+func (m *SystemAPIMock) SetCertificateName(id string, name string) error {
+	m.record("SetCertificateName", id, name)
+	if m.SetCertificateNameFunc == nil {
+		panic("SystemAPIMock.SetCertificateNameFunc: method is nil but SetCertificateName was just called")
+	}
+	return m.SetCertificateNameFunc(id, name)
+}
+
+// RemoveCertificate calls RemoveCertificateFunc.
+func (m *SystemAPIMock) RemoveCertificate(id string) error {
+	m.record("RemoveCertificate", id)
+	if m.RemoveCertificateFunc == nil {
+		panic("SystemAPIMock.RemoveCertificateFunc: method is nil but RemoveCertificate was just called")
+	}
+	return m.RemoveCertificateFunc(id)
+}
+
+// GetNTPClient calls GetNTPClientFunc.
+func (m *SystemAPIMock) GetNTPClient() (any, error) {
+	m.record("GetNTPClient")
+	if m.GetNTPClientFunc == nil {
+		panic("SystemAPIMock.GetNTPClientFunc: method is nil but GetNTPClient was just called")
+	}
+	return m.GetNTPClientFunc()
+}
+
+// SetNTPClient calls SetNTPClientFunc.
+func (m *SystemAPIMock) SetNTPClient(ntp any) error {
+	m.record("SetNTPClient", ntp)
+	if m.SetNTPClientFunc == nil {
+		panic("SystemAPIMock.SetNTPClientFunc: method is nil but SetNTPClient was just called")
+	}
+	return m.SetNTPClientFunc(ntp)
+}
+
+// GetSNMP calls GetSNMPFunc.
+func (m *SystemAPIMock) GetSNMP() (gotik.SNMP, error) {
+	m.record("GetSNMP")
+	if m.GetSNMPFunc == nil {
+		panic("SystemAPIMock.GetSNMPFunc: method is nil but GetSNMP was just called")
+	}
+	return m.GetSNMPFunc()
+}
+
+// SetSNMP calls SetSNMPFunc.
+func (m *SystemAPIMock) SetSNMP(s gotik.SNMP) error {
+	m.record("SetSNMP", s)
+	if m.SetSNMPFunc == nil {
+		panic("SystemAPIMock.SetSNMPFunc: method is nil but SetSNMP was just called")
+	}
+	return m.SetSNMPFunc(s)
+}
+
+// GetSNMPCommunities calls GetSNMPCommunitiesFunc.
+func (m *SystemAPIMock) GetSNMPCommunities() ([]gotik.SNMPCommunity, error) {
+	m.record("GetSNMPCommunities")
+	if m.GetSNMPCommunitiesFunc == nil {
+		panic("SystemAPIMock.GetSNMPCommunitiesFunc: method is nil but GetSNMPCommunities was just called")
+	}
+	return m.GetSNMPCommunitiesFunc()
+}
+
+// AddSNMPCommunity calls AddSNMPCommunityFunc.
+func (m *SystemAPIMock) AddSNMPCommunity(community gotik.SNMPCommunity) (string, error) {
+	m.record("AddSNMPCommunity", community)
+	if m.AddSNMPCommunityFunc == nil {
+		panic("SystemAPIMock.AddSNMPCommunityFunc: method is nil but AddSNMPCommunity was just called")
+	}
+	return m.AddSNMPCommunityFunc(community)
+}
+
+// UpdateSNMPCommunity calls UpdateSNMPCommunityFunc.
+func (m *SystemAPIMock) UpdateSNMPCommunity(community gotik.SNMPCommunity) (string, error) {
+	m.record("UpdateSNMPCommunity", community)
+	if m.UpdateSNMPCommunityFunc == nil {
+		panic("SystemAPIMock.UpdateSNMPCommunityFunc: method is nil but UpdateSNMPCommunity was just called")
+	}
+	return m.UpdateSNMPCommunityFunc(community)
+}
+
+// RemoveSNMPCommunity calls RemoveSNMPCommunityFunc.
+func (m *SystemAPIMock) RemoveSNMPCommunity(id string) error {
+	m.record("RemoveSNMPCommunity", id)
+	if m.RemoveSNMPCommunityFunc == nil {
+		panic("SystemAPIMock.RemoveSNMPCommunityFunc: method is nil but RemoveSNMPCommunity was just called")
+	}
+	return m.RemoveSNMPCommunityFunc(id)
+}
+
+// GetAAA calls GetAAAFunc.
+func (m *SystemAPIMock) GetAAA() (gotik.AAA, error) {
+	m.record("GetAAA")
+	if m.GetAAAFunc == nil {
+		panic("SystemAPIMock.GetAAAFunc: method is nil but GetAAA was just called")
+	}
+	return m.GetAAAFunc()
+}
+
+// SetAAA calls SetAAAFunc.
+func (m *SystemAPIMock) SetAAA(a gotik.AAA) (string, error) {
+	m.record("SetAAA", a)
+	if m.SetAAAFunc == nil {
+		panic("SystemAPIMock.SetAAAFunc: method is nil but SetAAA was just called")
+	}
+	return m.SetAAAFunc(a)
+}
+
+// GetRadius calls GetRadiusFunc.
+func (m *SystemAPIMock) GetRadius() ([]gotik.RadiusServer, error) {
+	m.record("GetRadius")
+	if m.GetRadiusFunc == nil {
+		panic("SystemAPIMock.GetRadiusFunc: method is nil but GetRadius was just called")
+	}
+	return m.GetRadiusFunc()
+}
+
+// AddRadius calls AddRadiusFunc.
+func (m *SystemAPIMock) AddRadius(r gotik.RadiusServer, placeBefore string) (string, error) {
+	m.record("AddRadius", r, placeBefore)
+	if m.AddRadiusFunc == nil {
+		panic("SystemAPIMock.AddRadiusFunc: method is nil but AddRadius was just called")
+	}
+	return m.AddRadiusFunc(r, placeBefore)
+}
+
+// RemoveRadius calls RemoveRadiusFunc.
+func (m *SystemAPIMock) RemoveRadius(id string) error {
+	m.record("RemoveRadius", id)
+	if m.RemoveRadiusFunc == nil {
+		panic("SystemAPIMock.RemoveRadiusFunc: method is nil but RemoveRadius was just called")
+	}
+	return m.RemoveRadiusFunc(id)
+}
+
+// RouterOSMock is a mock implementation of gotik.RouterOS.
+type RouterOSMock struct {
+	// AllowInsecureCleartextFunc mocks the AllowInsecureCleartext method.
+	AllowInsecureCleartextFunc func(value bool)
+
+	// LoginFunc mocks the Login method.
+	LoginFunc func(username string, password string) error
+
+	// CloseFunc mocks the Close method.
+	CloseFunc func()
+
+	// ShutdownFunc mocks the Shutdown method.
+	ShutdownFunc func(ctx context.Context) error
+
+	// CurrentAddressFunc mocks the CurrentAddress method.
+	CurrentAddressFunc func() string
+
+	// CurrentVersionFunc mocks the CurrentVersion method.
+	CurrentVersionFunc func() (string, int, int, int)
+
+	// AsyncFunc mocks the Async method.
+	AsyncFunc func() <-chan error
+
+	// RunFunc mocks the Run method.
+	RunFunc func(sentence ...string) (*gotik.Reply, error)
+
+	// RunArgsFunc mocks the RunArgs method.
+	RunArgsFunc func(sentence []string) (*gotik.Reply, error)
+
+	// RunCmdFunc mocks the RunCmd method.
+	RunCmdFunc func(cmd string, sentence ...string) (*gotik.Reply, error)
+
+	// RunAsyncFunc mocks the RunAsync method.
+	RunAsyncFunc func(sentence ...string) *gotik.Pending
+
+	// RunArgsAsyncFunc mocks the RunArgsAsync method.
+	RunArgsAsyncFunc func(sentence []string) *gotik.Pending
+
+	// BatchFunc mocks the Batch method.
+	BatchFunc func() *gotik.Batch
+
+	// ListenFunc mocks the Listen method.
+	ListenFunc func(sentence ...string) (*gotik.ListenReply, error)
+
+	// ListenArgsFunc mocks the ListenArgs method.
+	ListenArgsFunc func(sentence []string) (*gotik.ListenReply, error)
+
+	// ListenArgsQueueFunc mocks the ListenArgsQueue method.
+	ListenArgsQueueFunc func(sentence []string, queueSize int) (*gotik.ListenReply, error)
+
+	// SetDryRunFunc mocks the SetDryRun method.
+	SetDryRunFunc func(enabled bool)
+
+	// DryRunFunc mocks the DryRun method.
+	DryRunFunc func() bool
+
+	// ChangePlanFunc mocks the ChangePlan method.
+	ChangePlanFunc func() []gotik.PlannedChange
+
+	// ResetChangePlanFunc mocks the ResetChangePlan method.
+	ResetChangePlanFunc func()
+
+	// SetReadOnlyFunc mocks the SetReadOnly method.
+	SetReadOnlyFunc func(enabled bool)
+
+	// ReadOnlyFunc mocks the ReadOnly method.
+	ReadOnlyFunc func() bool
+
+	// SetJournalFunc mocks the SetJournal method.
+	SetJournalFunc func(j *gotik.Journal) error
+
+	// BeginTxFunc mocks the BeginTx method.
+	BeginTxFunc func() (*gotik.Tx, error)
+
+	// WithTxFunc mocks the WithTx method.
+	WithTxFunc func(fn func() error) error
+
+	// CommitConfirmFunc mocks the CommitConfirm method.
+	CommitConfirmFunc func(timeout time.Duration, changes func() error) error
+
+	// GetIPv4FiltersFunc mocks the GetIPv4Filters method.
+	GetIPv4FiltersFunc func(chain string) ([]gotik.IPv4FilterRule, error)
+
+	// RemoveIPv4FilterRuleFunc mocks the RemoveIPv4FilterRule method.
+	RemoveIPv4FilterRuleFunc func(id string) error
+
+	// EnableIPv4FilterRuleFunc mocks the EnableIPv4FilterRule method.
+	EnableIPv4FilterRuleFunc func(id string) error
+
+	// DisableIPv4FilterRuleFunc mocks the DisableIPv4FilterRule method.
+	DisableIPv4FilterRuleFunc func(id string) error
+
+	// ResetFilterCountersFunc mocks the ResetFilterCounters method.
+	ResetFilterCountersFunc func(chain string) error
+
+	// ResetCountersFunc mocks the ResetCounters method.
+	ResetCountersFunc func(id string) error
+
+	// SampleFilterRatesFunc mocks the SampleFilterRates method.
+	SampleFilterRatesFunc func(ctx context.Context, chain string, interval time.Duration) (<-chan gotik.FilterSample, error)
+
+	// ApplyFilterChainFunc mocks the ApplyFilterChain method.
+	ApplyFilterChainFunc func(chain string, desired []gotik.IPv4FilterRule) ([]gotik.ChainChange, error)
+
+	// GetIPv4NatFunc mocks the GetIPv4Nat method.
+	GetIPv4NatFunc func(chain string) ([]gotik.IPv4NatRule, error)
+
+	// RemoveIPv4NatRuleFunc mocks the RemoveIPv4NatRule method.
+	RemoveIPv4NatRuleFunc func(id string) error
+
+	// AddPortForwardFunc mocks the AddPortForward method.
+	AddPortForwardFunc func(inInterface string, protocol string, dstPort gotik.PortSet, toAddress string, toPorts gotik.PortSet, comment string) error
+
+	// AddMasqueradeFunc mocks the AddMasquerade method.
+	AddMasqueradeFunc func(outInterface string, comment string) error
+
+	// AddSrcNATFunc mocks the AddSrcNAT method.
+	AddSrcNATFunc func(srcAddress string, outInterface string, toAddress string, comment string) error
+
+	// GetIPv4MangleFunc mocks the GetIPv4Mangle method.
+	GetIPv4MangleFunc func(chain string) ([]gotik.IPv4MangleRule, error)
+
+	// AddIPv4MangleRuleFunc mocks the AddIPv4MangleRule method.
+	AddIPv4MangleRuleFunc func(rule gotik.IPv4MangleRule) (string, error)
+
+	// RemoveIPv4MangleRuleFunc mocks the RemoveIPv4MangleRule method.
+	RemoveIPv4MangleRuleFunc func(id string) error
+
+	// EnableIPv4MangleRuleFunc mocks the EnableIPv4MangleRule method.
+	EnableIPv4MangleRuleFunc func(id string) error
+
+	// DisableIPv4MangleRuleFunc mocks the DisableIPv4MangleRule method.
+	DisableIPv4MangleRuleFunc func(id string) error
+
+	// GetIPv4RawFunc mocks the GetIPv4Raw method.
+	GetIPv4RawFunc func(chain string) ([]gotik.IPv4RawRule, error)
+
+	// AddIPv4RawRuleFunc mocks the AddIPv4RawRule method.
+	AddIPv4RawRuleFunc func(rule gotik.IPv4RawRule) (string, error)
+
+	// RemoveIPv4RawRuleFunc mocks the RemoveIPv4RawRule method.
+	RemoveIPv4RawRuleFunc func(id string) error
+
+	// EnableIPv4RawRuleFunc mocks the EnableIPv4RawRule method.
+	EnableIPv4RawRuleFunc func(id string) error
+
+	// DisableIPv4RawRuleFunc mocks the DisableIPv4RawRule method.
+	DisableIPv4RawRuleFunc func(id string) error
+
+	// GetIPv6FiltersFunc mocks the GetIPv6Filters method.
+	GetIPv6FiltersFunc func(chain string) ([]gotik.IPv6FilterRule, error)
+
+	// AddIPv6FilterRuleFunc mocks the AddIPv6FilterRule method.
+	AddIPv6FilterRuleFunc func(rule gotik.IPv6FilterRule) (string, error)
+
+	// RemoveIPv6FilterRuleFunc mocks the RemoveIPv6FilterRule method.
+	RemoveIPv6FilterRuleFunc func(id string) error
+
+	// EnableIPv6FilterRuleFunc mocks the EnableIPv6FilterRule method.
+	EnableIPv6FilterRuleFunc func(id string) error
+
+	// DisableIPv6FilterRuleFunc mocks the DisableIPv6FilterRule method.
+	DisableIPv6FilterRuleFunc func(id string) error
+
+	// GetIPv6NatFunc mocks the GetIPv6Nat method.
+	GetIPv6NatFunc func(chain string) ([]gotik.IPv6NatRule, error)
+
+	// AddIPv6NatRuleFunc mocks the AddIPv6NatRule method.
+	AddIPv6NatRuleFunc func(rule gotik.IPv6NatRule) (string, error)
+
+	// RemoveIPv6NatRuleFunc mocks the RemoveIPv6NatRule method.
+	RemoveIPv6NatRuleFunc func(id string) error
+
+	// EnableIPv6NatRuleFunc mocks the EnableIPv6NatRule method.
+	EnableIPv6NatRuleFunc func(id string) error
+
+	// DisableIPv6NatRuleFunc mocks the DisableIPv6NatRule method.
+	DisableIPv6NatRuleFunc func(id string) error
+
+	// GetIPv6MangleFunc mocks the GetIPv6Mangle method.
+	GetIPv6MangleFunc func(chain string) ([]gotik.IPv6MangleRule, error)
+
+	// AddIPv6MangleRuleFunc mocks the AddIPv6MangleRule method.
+	AddIPv6MangleRuleFunc func(rule gotik.IPv6MangleRule) (string, error)
+
+	// RemoveIPv6MangleRuleFunc mocks the RemoveIPv6MangleRule method.
+	RemoveIPv6MangleRuleFunc func(id string) error
+
+	// EnableIPv6MangleRuleFunc mocks the EnableIPv6MangleRule method.
+	EnableIPv6MangleRuleFunc func(id string) error
+
+	// DisableIPv6MangleRuleFunc mocks the DisableIPv6MangleRule method.
+	DisableIPv6MangleRuleFunc func(id string) error
+
+	// GetIPv6RawFunc mocks the GetIPv6Raw method.
+	GetIPv6RawFunc func(chain string) ([]gotik.IPv6RawRule, error)
+
+	// AddIPv6RawRuleFunc mocks the AddIPv6RawRule method.
+	AddIPv6RawRuleFunc func(rule gotik.IPv6RawRule) (string, error)
+
+	// RemoveIPv6RawRuleFunc mocks the RemoveIPv6RawRule method.
+	RemoveIPv6RawRuleFunc func(id string) error
+
+	// EnableIPv6RawRuleFunc mocks the EnableIPv6RawRule method.
+	EnableIPv6RawRuleFunc func(id string) error
+
+	// DisableIPv6RawRuleFunc mocks the DisableIPv6RawRule method.
+	DisableIPv6RawRuleFunc func(id string) error
+
+	// GetIDSFunc mocks the GetIDS method.
+	GetIDSFunc func(in interface{}) ([]string, string, error)
+
+	// CommitRuleFunc mocks the CommitRule method.
+	CommitRuleFunc func(in interface{}) error
+
+	// AddRuleFunc mocks the AddRule method.
+	AddRuleFunc func(in interface{}) error
+
+	// RemoveRuleFunc mocks the RemoveRule method.
+	RemoveRuleFunc func(in interface{}) error
+
+	// RemoveRuleByIDFunc mocks the RemoveRuleByID method.
+	RemoveRuleByIDFunc func(in interface{}) error
+
+	// ModifyRuleFunc mocks the ModifyRule method.
+	ModifyRuleFunc func(in interface{}, action string) error
+
+	// MoveRuleFunc mocks the MoveRule method.
+	MoveRuleFunc func(in interface{}, before string) error
+
+	// RuleIsDisabledFunc mocks the RuleIsDisabled method.
+	RuleIsDisabledFunc func(in interface{}) (bool, error)
+
+	// AddObjectFunc mocks the AddObject method.
+	AddObjectFunc func(in interface{}) error
+
+	// GetIPv4AddressListFunc mocks the GetIPv4AddressList method.
+	GetIPv4AddressListFunc func(listname string) ([]gotik.AddressList, error)
+
+	// GetIPv6AddressListFunc mocks the GetIPv6AddressList method.
+	GetIPv6AddressListFunc func(listname string) ([]gotik.AddressList, error)
+
+	// AuditIPv4AddressListFunc mocks the AuditIPv4AddressList method.
+	AuditIPv4AddressListFunc func(listname string, list []gotik.AddressList, goodList map[string]string, applyAudits bool) ([]gotik.AddressListAudit, error)
+
+	// AuditIPv6AddressListFunc mocks the AuditIPv6AddressList method.
+	AuditIPv6AddressListFunc func(listname string, list []gotik.AddressList, goodList map[string]string, applyAudits bool) ([]gotik.AddressListAudit, error)
+
+	// GetConnectionsFunc mocks the GetConnections method.
+	GetConnectionsFunc func(f gotik.ConnectionFilter) ([]gotik.Connection, error)
+
+	// ListenConnectionsFunc mocks the ListenConnections method.
+	ListenConnectionsFunc func(f gotik.ConnectionFilter, fn func(arg0 gotik.Connection) error) error
+
+	// RemoveConnectionsFunc mocks the RemoveConnections method.
+	RemoveConnectionsFunc func(f gotik.ConnectionFilter) (int, error)
+
+	// GetConnectionTrackingFunc mocks the GetConnectionTracking method.
+	GetConnectionTrackingFunc func() (gotik.ConnectionTracking, error)
+
+	// SetConnectionTrackingFunc mocks the SetConnectionTracking method.
+	SetConnectionTrackingFunc func(settings gotik.ConnectionTracking) error
+
+	// GetInterfacesOfTypesFunc mocks the GetInterfacesOfTypes method.
+	GetInterfacesOfTypesFunc func(types ...string) ([]gotik.Interface, error)
+
+	// GetEthInterfacesFunc mocks the GetEthInterfaces method.
+	GetEthInterfacesFunc func() ([]gotik.Interface, error)
+
+	// GetBridgeInterfacesFunc mocks the GetBridgeInterfaces method.
+	GetBridgeInterfacesFunc func() ([]gotik.Interface, error)
+
+	// GetVlanInterfacesFunc mocks the GetVlanInterfaces method.
+	GetVlanInterfacesFunc func(baseIntf string) ([]gotik.Interface, error)
+
+	// GetVLANInterfaceFunc mocks the GetVLANInterface method.
+	GetVLANInterfaceFunc func(vlan int) (gotik.Interface, error)
+
+	// GetVLANInterfaceOnBaseFunc mocks the GetVLANInterfaceOnBase method.
+	GetVLANInterfaceOnBaseFunc func(baseIntf string, vlan int) (gotik.Interface, error)
+
+	// AddVLANInterfaceFunc mocks the AddVLANInterface method.
+	AddVLANInterfaceFunc func(intf gotik.Interface) (string, error)
+
+	// EnableInterfaceFunc mocks the EnableInterface method.
+	EnableInterfaceFunc func(id string) error
+
+	// DisableInterfaceFunc mocks the DisableInterface method.
+	DisableInterfaceFunc func(id string) error
+
+	// SetInterfaceCommentFunc mocks the SetInterfaceComment method.
+	SetInterfaceCommentFunc func(id string, comment string) error
+
+	// SetInterfaceNameFunc mocks the SetInterfaceName method.
+	SetInterfaceNameFunc func(id string, newName string) error
+
+	// GetArpTableFunc mocks the GetArpTable method.
+	GetArpTableFunc func() ([]gotik.ArpEntry, error)
+
+	// GetInterfaceArpTableFunc mocks the GetInterfaceArpTable method.
+	GetInterfaceArpTableFunc func(baseIntf string) ([]gotik.ArpEntry, error)
+
+	// ArpLookupByIPFunc mocks the ArpLookupByIP method.
+	ArpLookupByIPFunc func(ipv4 string) (gotik.ArpEntry, error)
+
+	// ArpLookupByMACFunc mocks the ArpLookupByMAC method.
+	ArpLookupByMACFunc func(mac string) (gotik.ArpEntry, error)
+
+	// GetNeighborInterfaceFunc mocks the GetNeighborInterface method.
+	GetNeighborInterfaceFunc func(iface string) (gotik.NeighborInterface, error)
+
+	// ModifyNeighborFunc mocks the ModifyNeighbor method.
+	ModifyNeighborFunc func(id string, action string) error
+
+	// GetIPv4TableFunc mocks the GetIPv4Table method.
+	GetIPv4TableFunc func() ([]gotik.IPv4Address, error)
+
+	// GetInterfaceIPv4TableFunc mocks the GetInterfaceIPv4Table method.
+	GetInterfaceIPv4TableFunc func(baseIntf string) ([]gotik.IPv4Address, error)
+
+	// GetCustomerIPv4SubnetsFunc mocks the GetCustomerIPv4Subnets method.
+	GetCustomerIPv4SubnetsFunc func(vlan int) ([]gotik.IPv4Address, error)
+
+	// AddIPv4AddressFunc mocks the AddIPv4Address method.
+	AddIPv4AddressFunc func(addr gotik.IPv4Address) (string, error)
+
+	// ModifyIPv4AddressFunc mocks the ModifyIPv4Address method.
+	ModifyIPv4AddressFunc func(id string, action string) error
+
+	// GetIPv4PoolsFunc mocks the GetIPv4Pools method.
+	GetIPv4PoolsFunc func() ([]gotik.IPv4Pool, error)
+
+	// GetIPv4PoolFunc mocks the GetIPv4Pool method.
+	GetIPv4PoolFunc func(name string) ([]gotik.IPv4Pool, error)
+
+	// AddIPv4PoolFunc mocks the AddIPv4Pool method.
+	AddIPv4PoolFunc func(pool gotik.IPv4Pool) (string, error)
+
+	// GetIPv4RoutesFunc mocks the GetIPv4Routes method.
+	GetIPv4RoutesFunc func(limiters []string) ([]gotik.IPv4Route, error)
+
+	// FindMatchingIPv4RoutesFunc mocks the FindMatchingIPv4Routes method.
+	FindMatchingIPv4RoutesFunc func(routes []gotik.IPv4Route, netToMatch *net.IPNet) ([]gotik.IPv4Route, error)
+
+	// ModifyIPv4RouteFunc mocks the ModifyIPv4Route method.
+	ModifyIPv4RouteFunc func(id string, action string) error
+
+	// GetIPv6SettingsFunc mocks the GetIPv6Settings method.
+	GetIPv6SettingsFunc func() (gotik.IPv6Settings, error)
+
+	// GetDhcpv4NetworksFunc mocks the GetDhcpv4Networks method.
+	GetDhcpv4NetworksFunc func() ([]gotik.DHCP4Network, error)
+
+	// AddDhcpv4NetworkFunc mocks the AddDhcpv4Network method.
+	AddDhcpv4NetworkFunc func(s gotik.DHCP4Network) (string, error)
+
+	// GetDhcpv4ServersFunc mocks the GetDhcpv4Servers method.
+	GetDhcpv4ServersFunc func() ([]gotik.DHCPv4Server, error)
+
+	// GetDhcp4ServerByNameFunc mocks the GetDhcp4ServerByName method.
+	GetDhcp4ServerByNameFunc func(name string) ([]gotik.DHCPv4Server, error)
+
+	// GetDhcp4ServerByIntfFunc mocks the GetDhcp4ServerByIntf method.
+	GetDhcp4ServerByIntfFunc func(intf string) ([]gotik.DHCPv4Server, error)
+
+	// AddDhcpv4ServerFunc mocks the AddDhcpv4Server method.
+	AddDhcpv4ServerFunc func(s gotik.DHCPv4Server) (string, error)
+
+	// SetDhcpv4ServerDisableFunc mocks the SetDhcpv4ServerDisable method.
+	SetDhcpv4ServerDisableFunc func(id string, disabled bool) error
+
+	// GetDNSFunc mocks the GetDNS method.
+	GetDNSFunc func() (gotik.DNS, error)
+
+	// SetDNSFunc mocks the SetDNS method.
+	SetDNSFunc func(d gotik.DNS) error
+
+	// FlushDNSFunc mocks the FlushDNS method.
+	FlushDNSFunc func() error
+
+	// GetIPServicesFunc mocks the GetIPServices method.
+	GetIPServicesFunc func() ([]gotik.IPService, error)
+
+	// SetIPServiceFunc mocks the SetIPService method.
+	SetIPServiceFunc func(id string, disabled bool, port int, address string, cert string, tlsVersion string) error
+
+	// SetIPServiceDisableFunc mocks the SetIPServiceDisable method.
+	SetIPServiceDisableFunc func(id string, disabled bool) error
+
+	// GetPPPSecretsFunc mocks the GetPPPSecrets method.
+	GetPPPSecretsFunc func() ([]gotik.PPPSecret, error)
+
+	// GetPPPSecretByNameFunc mocks the GetPPPSecretByName method.
+	GetPPPSecretByNameFunc func(name string) (gotik.PPPSecret, error)
+
+	// AddPPPSecretFunc mocks the AddPPPSecret method.
+	AddPPPSecretFunc func(secret gotik.PPPSecret) (string, error)
+
+	// UpdatePPPSecretFunc mocks the UpdatePPPSecret method.
+	UpdatePPPSecretFunc func(secret gotik.PPPSecret) (string, error)
+
+	// RemovePPPSecretFunc mocks the RemovePPPSecret method.
+	RemovePPPSecretFunc func(id string) error
+
+	// RemovePPPSecretByNameFunc mocks the RemovePPPSecretByName method.
+	RemovePPPSecretByNameFunc func(name string) error
+
+	// GetPPPActiveConnectionsFunc mocks the GetPPPActiveConnections method.
+	GetPPPActiveConnectionsFunc func() ([]gotik.PPPActive, error)
+
+	// GetPPPActiveConnectionByNameFunc mocks the GetPPPActiveConnectionByName method.
+	GetPPPActiveConnectionByNameFunc func(name string) (gotik.PPPActive, error)
+
+	// GetPPPoEServersFunc mocks the GetPPPoEServers method.
+	GetPPPoEServersFunc func(intf string) ([]gotik.PPPoEServer, error)
+
+	// RemovePPPoEServerFunc mocks the RemovePPPoEServer method.
+	RemovePPPoEServerFunc func(id string) error
+
+	// GetQueueTreeByNameFunc mocks the GetQueueTreeByName method.
+	GetQueueTreeByNameFunc func(name string) (gotik.QueueTree, error)
+
+	// GetQueueTreeFunc mocks the GetQueueTree method.
+	GetQueueTreeFunc func(parent string) ([]gotik.QueueTree, error)
+
+	// GetQueueTreeAllFunc mocks the GetQueueTreeAll method.
+	GetQueueTreeAllFunc func() ([]gotik.QueueTree, error)
+
+	// AddQueueTreeFunc mocks the AddQueueTree method.
+	AddQueueTreeFunc func(queue *gotik.QueueTree) error
+
+	// AddQueueTreeSingleFunc mocks the AddQueueTreeSingle method.
+	AddQueueTreeSingleFunc func(queue gotik.QueueTree) (string, error)
+
+	// RemoveQueueTreeFunc mocks the RemoveQueueTree method.
+	RemoveQueueTreeFunc func(queue gotik.QueueTree, removeChildren bool) error
+
+	// RemoveQueueTreeByNameFunc mocks the RemoveQueueTreeByName method.
+	RemoveQueueTreeByNameFunc func(name string) error
+
+	// GetSimpleQueuesFunc mocks the GetSimpleQueues method.
+	GetSimpleQueuesFunc func(target string) ([]gotik.SimpleQueue, error)
+
+	// RemoveSimpleQueueFunc mocks the RemoveSimpleQueue method.
+	RemoveSimpleQueueFunc func(ID string) error
+
+	// GetOspf2LsaTableFunc mocks the GetOspf2LsaTable method.
+	GetOspf2LsaTableFunc func() ([]gotik.OSPF2LSA, error)
+
+	// GetSystemResourcesFunc mocks the GetSystemResources method.
+	GetSystemResourcesFunc func() (gotik.Resources, error)
+
+	// GetSystemRouterboardFunc mocks the GetSystemRouterboard method.
+	GetSystemRouterboardFunc func() (gotik.Routerboard, error)
+
+	// GetSystemIdFunc mocks the GetSystemId method.
+	GetSystemIdFunc func() (string, error)
+
+	// GetSystemLicenseFunc mocks the GetSystemLicense method.
+	GetSystemLicenseFunc func() (gotik.License, error)
+
+	// CreateExportFunc mocks the CreateExport method.
+	CreateExportFunc func(targetname string, minFreeSpace int) error
+
+	// ExportConfigFunc mocks the ExportConfig method.
+	ExportConfigFunc func(base string, filename string, hideSensitive bool) error
+
+	// ExportConfigToWriterFunc mocks the ExportConfigToWriter method.
+	ExportConfigToWriterFunc func(base string, w io.Writer, hideSensitive bool) error
+
+	// FetchFunc mocks the Fetch method.
+	FetchFunc func(filename string, hideSensitive bool) error
+
+	// GetPackagesFunc mocks the GetPackages method.
+	GetPackagesFunc func() ([]gotik.Package, error)
+
+	// IsPackageEnabledFunc mocks the IsPackageEnabled method.
+	IsPackageEnabledFunc func(name string) (bool, error)
+
+	// GetUpdateInfoFunc mocks the GetUpdateInfo method.
+	GetUpdateInfoFunc func() (gotik.PackageUpdate, error)
+
+	// CheckForUpdatesFunc mocks the CheckForUpdates method.
+	CheckForUpdatesFunc func() (gotik.PackageUpdate, error)
+
+	// SetUpdateChannelFunc mocks the SetUpdateChannel method.
+	SetUpdateChannelFunc func(channel string) error
+
+	// DownloadUpdatesFunc mocks the DownloadUpdates method.
+	DownloadUpdatesFunc func() (gotik.PackageUpdate, error)
+
+	// InstallUpdatesFunc mocks the InstallUpdates method.
+	InstallUpdatesFunc func() (gotik.PackageUpdate, error)
+
+	// GetUsersFunc mocks the GetUsers method.
+	GetUsersFunc func() ([]gotik.User, error)
+
+	// GetUserByNameFunc mocks the GetUserByName method.
+	GetUserByNameFunc func(name string) (gotik.User, error)
+
+	// AddUserFunc mocks the AddUser method.
+	AddUserFunc func(user gotik.User) (string, error)
+
+	// UpdateUserFunc mocks the UpdateUser method.
+	UpdateUserFunc func(user gotik.User) (string, error)
+
+	// UpdateUserPasswordByIDFunc mocks the UpdateUserPasswordByID method.
+	UpdateUserPasswordByIDFunc func(ID string, password string) (string, error)
+
+	// UpdateUserPasswordByNameFunc mocks the UpdateUserPasswordByName method.
+	UpdateUserPasswordByNameFunc func(username string, password string) (string, error)
+
+	// RemoveUserFunc mocks the RemoveUser method.
+	RemoveUserFunc func(id string) error
+
+	// RemoveUserByNameFunc mocks the RemoveUserByName method.
+	RemoveUserByNameFunc func(name string) error
+
+	// GetGroupsFunc mocks the GetGroups method.
+	GetGroupsFunc func() ([]gotik.Group, error)
+
+	// GetGroupByNameFunc mocks the GetGroupByName method.
+	GetGroupByNameFunc func(name string) (gotik.Group, error)
+
+	// AddGroupFunc mocks the AddGroup method.
+	AddGroupFunc func(g gotik.Group) (string, error)
+
+	// UpdateGroupFunc mocks the UpdateGroup method.
+	UpdateGroupFunc func(g gotik.Group) (string, error)
+
+	// RemoveGroupFunc mocks the RemoveGroup method.
+	RemoveGroupFunc func(id string) error
+
+	// RemoveGroupByNameFunc mocks the RemoveGroupByName method.
+	RemoveGroupByNameFunc func(name string) error
+
+	// GetScriptsFunc mocks the GetScripts method.
+	GetScriptsFunc func() ([]gotik.Script, error)
+
+	// AddScriptFunc mocks the AddScript method.
+	AddScriptFunc func(s gotik.Script) (string, error)
+
+	// UpdateScriptFunc mocks the UpdateScript method.
+	UpdateScriptFunc func(s gotik.Script) (string, error)
+
+	// RemoveScriptFunc mocks the RemoveScript method.
+	RemoveScriptFunc func(id string) error
+
+	// GetSchedulerFunc mocks the GetScheduler method.
+	GetSchedulerFunc func() ([]gotik.Schedule, error)
+
+	// AddScheduleFunc mocks the AddSchedule method.
+	AddScheduleFunc func(s gotik.Schedule) (string, error)
+
+	// UpdateScheduleFunc mocks the UpdateSchedule method.
+	UpdateScheduleFunc func(s gotik.Schedule) (string, error)
+
+	// RemoveScheduleFunc mocks the RemoveSchedule method.
+	RemoveScheduleFunc func(id string) error
+
+	// GetAllFilesFunc mocks the GetAllFiles method.
+	GetAllFilesFunc func() ([]gotik.File, error)
+
+	// AddFileFunc mocks the AddFile method.
+	AddFileFunc func(name string, contents string) error
+
+	// RemoveFileByNameFunc mocks the RemoveFileByName method.
+	RemoveFileByNameFunc func(name string) error
+
+	// RemoveFileByIDFunc mocks the RemoveFileByID method.
+	RemoveFileByIDFunc func(id string) error
+
+	// ReadFileFunc mocks the ReadFile method.
+	ReadFileFunc func(name string) ([]byte, error)
+
+	// DownloadFileFunc mocks the DownloadFile method.
+	DownloadFileFunc func(name string, w io.Writer) error
+
+	// UploadFileFunc mocks the UploadFile method.
+	UploadFileFunc func(name string, r io.Reader) error
+
+	// ImportScriptFunc mocks the ImportScript method.
+	ImportScriptFunc func(name string, opts gotik.ImportOptions) (gotik.ImportResult, error)
+
+	// ImportStringFunc mocks the ImportString method.
+	ImportStringFunc func(script string, opts gotik.ImportOptions) (gotik.ImportResult, error)
+
+	// GetCertificatesFunc mocks the GetCertificates method.
+	GetCertificatesFunc func() ([]gotik.Certificate, error)
+
+	// CertificateImportFunc mocks the CertificateImport method.
+	CertificateImportFunc func(name string, filename string, passphrase string) (gotik.CertImportResults, error)
+
+	// SetCertificateNameFunc mocks the SetCertificateName method.
+	SetCertificateNameFunc func(id string, name string) error
+
+	// RemoveCertificateFunc mocks the RemoveCertificate method.
+	RemoveCertificateFunc func(id string) error
+
+	// GetNTPClientFunc mocks the GetNTPClient method.
+	GetNTPClientFunc func() (any, error)
+
+	// SetNTPClientFunc mocks the SetNTPClient method.
+	SetNTPClientFunc func(ntp any) error
+
+	// GetSNMPFunc mocks the GetSNMP method.
+	GetSNMPFunc func() (gotik.SNMP, error)
+
+	// SetSNMPFunc mocks the SetSNMP method.
+	SetSNMPFunc func(s gotik.SNMP) error
+
+	// GetSNMPCommunitiesFunc mocks the GetSNMPCommunities method.
+	GetSNMPCommunitiesFunc func() ([]gotik.SNMPCommunity, error)
+
+	// AddSNMPCommunityFunc mocks the AddSNMPCommunity method.
+	AddSNMPCommunityFunc func(community gotik.SNMPCommunity) (string, error)
+
+	// UpdateSNMPCommunityFunc mocks the UpdateSNMPCommunity method.
+	UpdateSNMPCommunityFunc func(community gotik.SNMPCommunity) (string, error)
+
+	// RemoveSNMPCommunityFunc mocks the RemoveSNMPCommunity method.
+	RemoveSNMPCommunityFunc func(id string) error
+
+	// GetAAAFunc mocks the GetAAA method.
+	GetAAAFunc func() (gotik.AAA, error)
+
+	// SetAAAFunc mocks the SetAAA method.
+	SetAAAFunc func(a gotik.AAA) (string, error)
+
+	// GetRadiusFunc mocks the GetRadius method.
+	GetRadiusFunc func() ([]gotik.RadiusServer, error)
+
+	// AddRadiusFunc mocks the AddRadius method.
+	AddRadiusFunc func(r gotik.RadiusServer, placeBefore string) (string, error)
+
+	// RemoveRadiusFunc mocks the RemoveRadius method.
+	RemoveRadiusFunc func(id string) error
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made on the mock so far, in order.
+func (m *RouterOSMock) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *RouterOSMock) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+	m.mu.Unlock()
+}
+
+// AllowInsecureCleartext calls AllowInsecureCleartextFunc.
+func (m *RouterOSMock) AllowInsecureCleartext(value bool) {
+	m.record("AllowInsecureCleartext", value)
+	if m.AllowInsecureCleartextFunc == nil {
+		panic("RouterOSMock.AllowInsecureCleartextFunc: method is nil but AllowInsecureCleartext was just called")
+	}
+	m.AllowInsecureCleartextFunc(value)
+}
+
+// Login calls LoginFunc.
+func (m *RouterOSMock) Login(username string, password string) error {
+	m.record("Login", username, password)
+	if m.LoginFunc == nil {
+		panic("RouterOSMock.LoginFunc: method is nil but Login was just called")
+	}
+	return m.LoginFunc(username, password)
+}
+
+// Close calls CloseFunc.
+func (m *RouterOSMock) Close() {
+	m.record("Close")
+	if m.CloseFunc == nil {
+		panic("RouterOSMock.CloseFunc: method is nil but Close was just called")
+	}
+	m.CloseFunc()
+}
+
+// Shutdown calls ShutdownFunc.
+func (m *RouterOSMock) Shutdown(ctx context.Context) error {
+	m.record("Shutdown", ctx)
+	if m.ShutdownFunc == nil {
+		panic("RouterOSMock.ShutdownFunc: method is nil but Shutdown was just called")
+	}
+	return m.ShutdownFunc(ctx)
+}
+
+// CurrentAddress calls CurrentAddressFunc.
+func (m *RouterOSMock) CurrentAddress() string {
+	m.record("CurrentAddress")
+	if m.CurrentAddressFunc == nil {
+		panic("RouterOSMock.CurrentAddressFunc: method is nil but CurrentAddress was just called")
+	}
+	return m.CurrentAddressFunc()
+}
+
+// CurrentVersion calls CurrentVersionFunc.
+func (m *RouterOSMock) CurrentVersion() (string, int, int, int) {
+	m.record("CurrentVersion")
+	if m.CurrentVersionFunc == nil {
+		panic("RouterOSMock.CurrentVersionFunc: method is nil but CurrentVersion was just called")
+	}
+	return m.CurrentVersionFunc()
+}
+
+// Async calls AsyncFunc.
+func (m *RouterOSMock) Async() <-chan error {
+	m.record("Async")
+	if m.AsyncFunc == nil {
+		panic("RouterOSMock.AsyncFunc: method is nil but Async was just called")
+	}
+	return m.AsyncFunc()
+}
+
+// Run calls RunFunc.
+func (m *RouterOSMock) Run(sentence ...string) (*gotik.Reply, error) {
+	m.record("Run", sentence)
+	if m.RunFunc == nil {
+		panic("RouterOSMock.RunFunc: method is nil but Run was just called")
+	}
+	return m.RunFunc(sentence...)
+}
+
+// RunArgs calls RunArgsFunc.
+func (m *RouterOSMock) RunArgs(sentence []string) (*gotik.Reply, error) {
+	m.record("RunArgs", sentence)
+	if m.RunArgsFunc == nil {
+		panic("RouterOSMock.RunArgsFunc: method is nil but RunArgs was just called")
+	}
+	return m.RunArgsFunc(sentence)
+}
+
+// RunCmd calls RunCmdFunc.
+func (m *RouterOSMock) RunCmd(cmd string, sentence ...string) (*gotik.Reply, error) {
+	m.record("RunCmd", cmd, sentence)
+	if m.RunCmdFunc == nil {
+		panic("RouterOSMock.RunCmdFunc: method is nil but RunCmd was just called")
+	}
+	return m.RunCmdFunc(cmd, sentence...)
+}
+
+// RunAsync calls RunAsyncFunc.
+func (m *RouterOSMock) RunAsync(sentence ...string) *gotik.Pending {
+	m.record("RunAsync", sentence)
+	if m.RunAsyncFunc == nil {
+		panic("RouterOSMock.RunAsyncFunc: method is nil but RunAsync was just called")
+	}
+	return m.RunAsyncFunc(sentence...)
+}
+
+// RunArgsAsync calls RunArgsAsyncFunc.
+func (m *RouterOSMock) RunArgsAsync(sentence []string) *gotik.Pending {
+	m.record("RunArgsAsync", sentence)
+	if m.RunArgsAsyncFunc == nil {
+		panic("RouterOSMock.RunArgsAsyncFunc: method is nil but RunArgsAsync was just called")
+	}
+	return m.RunArgsAsyncFunc(sentence)
+}
+
+// Batch calls BatchFunc.
+func (m *RouterOSMock) Batch() *gotik.Batch {
+	m.record("Batch")
+	if m.BatchFunc == nil {
+		panic("RouterOSMock.BatchFunc: method is nil but Batch was just called")
+	}
+	return m.BatchFunc()
+}
+
+// Listen calls ListenFunc.
+func (m *RouterOSMock) Listen(sentence ...string) (*gotik.ListenReply, error) {
+	m.record("Listen", sentence)
+	if m.ListenFunc == nil {
+		panic("RouterOSMock.ListenFunc: method is nil but Listen was just called")
+	}
+	return m.ListenFunc(sentence...)
+}
+
+// ListenArgs calls ListenArgsFunc.
+func (m *RouterOSMock) ListenArgs(sentence []string) (*gotik.ListenReply, error) {
+	m.record("ListenArgs", sentence)
+	if m.ListenArgsFunc == nil {
+		panic("RouterOSMock.ListenArgsFunc: method is nil but ListenArgs was just called")
+	}
+	return m.ListenArgsFunc(sentence)
+}
+
+// ListenArgsQueue calls ListenArgsQueueFunc.
+func (m *RouterOSMock) ListenArgsQueue(sentence []string, queueSize int) (*gotik.ListenReply, error) {
+	m.record("ListenArgsQueue", sentence, queueSize)
+	if m.ListenArgsQueueFunc == nil {
+		panic("RouterOSMock.ListenArgsQueueFunc: method is nil but ListenArgsQueue was just called")
+	}
+	return m.ListenArgsQueueFunc(sentence, queueSize)
+}
+
+// SetDryRun calls SetDryRunFunc.
+func (m *RouterOSMock) SetDryRun(enabled bool) {
+	m.record("SetDryRun", enabled)
+	if m.SetDryRunFunc == nil {
+		panic("RouterOSMock.SetDryRunFunc: method is nil but SetDryRun was just called")
+	}
+	m.SetDryRunFunc(enabled)
+}
+
+// DryRun calls DryRunFunc.
+func (m *RouterOSMock) DryRun() bool {
+	m.record("DryRun")
+	if m.DryRunFunc == nil {
+		panic("RouterOSMock.DryRunFunc: method is nil but DryRun was just called")
+	}
+	return m.DryRunFunc()
+}
+
+// ChangePlan calls ChangePlanFunc.
+func (m *RouterOSMock) ChangePlan() []gotik.PlannedChange {
+	m.record("ChangePlan")
+	if m.ChangePlanFunc == nil {
+		panic("RouterOSMock.ChangePlanFunc: method is nil but ChangePlan was just called")
+	}
+	return m.ChangePlanFunc()
+}
+
+// ResetChangePlan calls ResetChangePlanFunc.
+func (m *RouterOSMock) ResetChangePlan() {
+	m.record("ResetChangePlan")
+	if m.ResetChangePlanFunc == nil {
+		panic("RouterOSMock.ResetChangePlanFunc: method is nil but ResetChangePlan was just called")
+	}
+	m.ResetChangePlanFunc()
+}
+
+// SetReadOnly calls SetReadOnlyFunc.
+func (m *RouterOSMock) SetReadOnly(enabled bool) {
+	m.record("SetReadOnly", enabled)
+	if m.SetReadOnlyFunc == nil {
+		panic("RouterOSMock.SetReadOnlyFunc: method is nil but SetReadOnly was just called")
+	}
+	m.SetReadOnlyFunc(enabled)
+}
+
+// ReadOnly calls ReadOnlyFunc.
+func (m *RouterOSMock) ReadOnly() bool {
+	m.record("ReadOnly")
+	if m.ReadOnlyFunc == nil {
+		panic("RouterOSMock.ReadOnlyFunc: method is nil but ReadOnly was just called")
+	}
+	return m.ReadOnlyFunc()
+}
+
+// SetJournal calls SetJournalFunc.
+func (m *RouterOSMock) SetJournal(j *gotik.Journal) error {
+	m.record("SetJournal", j)
+	if m.SetJournalFunc == nil {
+		panic("RouterOSMock.SetJournalFunc: method is nil but SetJournal was just called")
+	}
+	return m.SetJournalFunc(j)
+}
+
+// BeginTx calls BeginTxFunc.
+func (m *RouterOSMock) BeginTx() (*gotik.Tx, error) {
+	m.record("BeginTx")
+	if m.BeginTxFunc == nil {
+		panic("RouterOSMock.BeginTxFunc: method is nil but BeginTx was just called")
+	}
+	return m.BeginTxFunc()
+}
+
+// WithTx calls WithTxFunc.
+func (m *RouterOSMock) WithTx(fn func() error) error {
+	m.record("WithTx", fn)
+	if m.WithTxFunc == nil {
+		panic("RouterOSMock.WithTxFunc: method is nil but WithTx was just called")
+	}
+	return m.WithTxFunc(fn)
+}
+
+// CommitConfirm calls CommitConfirmFunc.
+func (m *RouterOSMock) CommitConfirm(timeout time.Duration, changes func() error) error {
+	m.record("CommitConfirm", timeout, changes)
+	if m.CommitConfirmFunc == nil {
+		panic("RouterOSMock.CommitConfirmFunc: method is nil but CommitConfirm was just called")
+	}
+	return m.CommitConfirmFunc(timeout, changes)
+}
+
+// GetIPv4Filters calls GetIPv4FiltersFunc.
+func (m *RouterOSMock) GetIPv4Filters(chain string) ([]gotik.IPv4FilterRule, error) {
+	m.record("GetIPv4Filters", chain)
+	if m.GetIPv4FiltersFunc == nil {
+		panic("RouterOSMock.GetIPv4FiltersFunc: method is nil but GetIPv4Filters was just called")
+	}
+	return m.GetIPv4FiltersFunc(chain)
+}
+
+// RemoveIPv4FilterRule calls RemoveIPv4FilterRuleFunc.
+func (m *RouterOSMock) RemoveIPv4FilterRule(id string) error {
+	m.record("RemoveIPv4FilterRule", id)
+	if m.RemoveIPv4FilterRuleFunc == nil {
+		panic("RouterOSMock.RemoveIPv4FilterRuleFunc: method is nil but RemoveIPv4FilterRule was just called")
+	}
+	return m.RemoveIPv4FilterRuleFunc(id)
+}
+
+// EnableIPv4FilterRule calls EnableIPv4FilterRuleFunc.
+func (m *RouterOSMock) EnableIPv4FilterRule(id string) error {
+	m.record("EnableIPv4FilterRule", id)
+	if m.EnableIPv4FilterRuleFunc == nil {
+		panic("RouterOSMock.EnableIPv4FilterRuleFunc: method is nil but EnableIPv4FilterRule was just called")
+	}
+	return m.EnableIPv4FilterRuleFunc(id)
+}
+
+// DisableIPv4FilterRule calls DisableIPv4FilterRuleFunc.
+func (m *RouterOSMock) DisableIPv4FilterRule(id string) error {
+	m.record("DisableIPv4FilterRule", id)
+	if m.DisableIPv4FilterRuleFunc == nil {
+		panic("RouterOSMock.DisableIPv4FilterRuleFunc: method is nil but DisableIPv4FilterRule was just called")
+	}
+	return m.DisableIPv4FilterRuleFunc(id)
+}
+
+// ResetFilterCounters calls ResetFilterCountersFunc.
+func (m *RouterOSMock) ResetFilterCounters(chain string) error {
+	m.record("ResetFilterCounters", chain)
+	if m.ResetFilterCountersFunc == nil {
+		panic("RouterOSMock.ResetFilterCountersFunc: method is nil but ResetFilterCounters was just called")
+	}
+	return m.ResetFilterCountersFunc(chain)
+}
+
+// ResetCounters calls ResetCountersFunc.
+func (m *RouterOSMock) ResetCounters(id string) error {
+	m.record("ResetCounters", id)
+	if m.ResetCountersFunc == nil {
+		panic("RouterOSMock.ResetCountersFunc: method is nil but ResetCounters was just called")
+	}
+	return m.ResetCountersFunc(id)
+}
+
+// SampleFilterRates calls SampleFilterRatesFunc.
+func (m *RouterOSMock) SampleFilterRates(ctx context.Context, chain string, interval time.Duration) (<-chan gotik.FilterSample, error) {
+	m.record("SampleFilterRates", ctx, chain, interval)
+	if m.SampleFilterRatesFunc == nil {
+		panic("RouterOSMock.SampleFilterRatesFunc: method is nil but SampleFilterRates was just called")
+	}
+	return m.SampleFilterRatesFunc(ctx, chain, interval)
+}
+
+// ApplyFilterChain calls ApplyFilterChainFunc.
+func (m *RouterOSMock) ApplyFilterChain(chain string, desired []gotik.IPv4FilterRule) ([]gotik.ChainChange, error) {
+	m.record("ApplyFilterChain", chain, desired)
+	if m.ApplyFilterChainFunc == nil {
+		panic("RouterOSMock.ApplyFilterChainFunc: method is nil but ApplyFilterChain was just called")
+	}
+	return m.ApplyFilterChainFunc(chain, desired)
+}
+
+// GetIPv4Nat calls GetIPv4NatFunc.
+func (m *RouterOSMock) GetIPv4Nat(chain string) ([]gotik.IPv4NatRule, error) {
+	m.record("GetIPv4Nat", chain)
+	if m.GetIPv4NatFunc == nil {
+		panic("RouterOSMock.GetIPv4NatFunc: method is nil but GetIPv4Nat was just called")
+	}
+	return m.GetIPv4NatFunc(chain)
+}
+
+// RemoveIPv4NatRule calls RemoveIPv4NatRuleFunc.
+func (m *RouterOSMock) RemoveIPv4NatRule(id string) error {
+	m.record("RemoveIPv4NatRule", id)
+	if m.RemoveIPv4NatRuleFunc == nil {
+		panic("RouterOSMock.RemoveIPv4NatRuleFunc: method is nil but RemoveIPv4NatRule was just called")
+	}
+	return m.RemoveIPv4NatRuleFunc(id)
+}
+
+// AddPortForward calls AddPortForwardFunc.
+func (m *RouterOSMock) AddPortForward(inInterface string, protocol string, dstPort gotik.PortSet, toAddress string, toPorts gotik.PortSet, comment string) error {
+	m.record("AddPortForward", inInterface, protocol, dstPort, toAddress, toPorts, comment)
+	if m.AddPortForwardFunc == nil {
+		panic("RouterOSMock.AddPortForwardFunc: method is nil but AddPortForward was just called")
+	}
+	return m.AddPortForwardFunc(inInterface, protocol, dstPort, toAddress, toPorts, comment)
+}
+
+// AddMasquerade calls AddMasqueradeFunc.
+func (m *RouterOSMock) AddMasquerade(outInterface string, comment string) error {
+	m.record("AddMasquerade", outInterface, comment)
+	if m.AddMasqueradeFunc == nil {
+		panic("RouterOSMock.AddMasqueradeFunc: method is nil but AddMasquerade was just called")
+	}
+	return m.AddMasqueradeFunc(outInterface, comment)
+}
+
+// AddSrcNAT calls AddSrcNATFunc.
+func (m *RouterOSMock) AddSrcNAT(srcAddress string, outInterface string, toAddress string, comment string) error {
+	m.record("AddSrcNAT", srcAddress, outInterface, toAddress, comment)
+	if m.AddSrcNATFunc == nil {
+		panic("RouterOSMock.AddSrcNATFunc: method is nil but AddSrcNAT was just called")
+	}
+	return m.AddSrcNATFunc(srcAddress, outInterface, toAddress, comment)
+}
+
+// GetIPv4Mangle calls GetIPv4MangleFunc.
+func (m *RouterOSMock) GetIPv4Mangle(chain string) ([]gotik.IPv4MangleRule, error) {
+	m.record("GetIPv4Mangle", chain)
+	if m.GetIPv4MangleFunc == nil {
+		panic("RouterOSMock.GetIPv4MangleFunc: method is nil but GetIPv4Mangle was just called")
+	}
+	return m.GetIPv4MangleFunc(chain)
+}
+
+// AddIPv4MangleRule calls AddIPv4MangleRuleFunc.
+func (m *RouterOSMock) AddIPv4MangleRule(rule gotik.IPv4MangleRule) (string, error) {
+	m.record("AddIPv4MangleRule", rule)
+	if m.AddIPv4MangleRuleFunc == nil {
+		panic("RouterOSMock.AddIPv4MangleRuleFunc: method is nil but AddIPv4MangleRule was just called")
+	}
+	return m.AddIPv4MangleRuleFunc(rule)
+}
+
+// RemoveIPv4MangleRule calls RemoveIPv4MangleRuleFunc.
+func (m *RouterOSMock) RemoveIPv4MangleRule(id string) error {
+	m.record("RemoveIPv4MangleRule", id)
+	if m.RemoveIPv4MangleRuleFunc == nil {
+		panic("RouterOSMock.RemoveIPv4MangleRuleFunc: method is nil but RemoveIPv4MangleRule was just called")
+	}
+	return m.RemoveIPv4MangleRuleFunc(id)
+}
+
+// EnableIPv4MangleRule calls EnableIPv4MangleRuleFunc.
+func (m *RouterOSMock) EnableIPv4MangleRule(id string) error {
+	m.record("EnableIPv4MangleRule", id)
+	if m.EnableIPv4MangleRuleFunc == nil {
+		panic("RouterOSMock.EnableIPv4MangleRuleFunc: method is nil but EnableIPv4MangleRule was just called")
+	}
+	return m.EnableIPv4MangleRuleFunc(id)
+}
+
+// DisableIPv4MangleRule calls DisableIPv4MangleRuleFunc.
+func (m *RouterOSMock) DisableIPv4MangleRule(id string) error {
+	m.record("DisableIPv4MangleRule", id)
+	if m.DisableIPv4MangleRuleFunc == nil {
+		panic("RouterOSMock.DisableIPv4MangleRuleFunc: method is nil but DisableIPv4MangleRule was just called")
+	}
+	return m.DisableIPv4MangleRuleFunc(id)
+}
+
+// GetIPv4Raw calls GetIPv4RawFunc.
+func (m *RouterOSMock) GetIPv4Raw(chain string) ([]gotik.IPv4RawRule, error) {
+	m.record("GetIPv4Raw", chain)
+	if m.GetIPv4RawFunc == nil {
+		panic("RouterOSMock.GetIPv4RawFunc: method is nil but GetIPv4Raw was just called")
+	}
+	return m.GetIPv4RawFunc(chain)
+}
+
+// AddIPv4RawRule calls AddIPv4RawRuleFunc.
+func (m *RouterOSMock) AddIPv4RawRule(rule gotik.IPv4RawRule) (string, error) {
+	m.record("AddIPv4RawRule", rule)
+	if m.AddIPv4RawRuleFunc == nil {
+		panic("RouterOSMock.AddIPv4RawRuleFunc: method is nil but AddIPv4RawRule was just called")
+	}
+	return m.AddIPv4RawRuleFunc(rule)
+}
+
+// RemoveIPv4RawRule calls RemoveIPv4RawRuleFunc.
+func (m *RouterOSMock) RemoveIPv4RawRule(id string) error {
+	m.record("RemoveIPv4RawRule", id)
+	if m.RemoveIPv4RawRuleFunc == nil {
+		panic("RouterOSMock.RemoveIPv4RawRuleFunc: method is nil but RemoveIPv4RawRule was just called")
+	}
+	return m.RemoveIPv4RawRuleFunc(id)
+}
+
+// EnableIPv4RawRule calls EnableIPv4RawRuleFunc.
+func (m *RouterOSMock) EnableIPv4RawRule(id string) error {
+	m.record("EnableIPv4RawRule", id)
+	if m.EnableIPv4RawRuleFunc == nil {
+		panic("RouterOSMock.EnableIPv4RawRuleFunc: method is nil but EnableIPv4RawRule was just called")
+	}
+	return m.EnableIPv4RawRuleFunc(id)
+}
+
+// DisableIPv4RawRule calls DisableIPv4RawRuleFunc.
+func (m *RouterOSMock) DisableIPv4RawRule(id string) error {
+	m.record("DisableIPv4RawRule", id)
+	if m.DisableIPv4RawRuleFunc == nil {
+		panic("RouterOSMock.DisableIPv4RawRuleFunc: method is nil but DisableIPv4RawRule was just called")
+	}
+	return m.DisableIPv4RawRuleFunc(id)
+}
+
+// GetIPv6Filters calls GetIPv6FiltersFunc.
+func (m *RouterOSMock) GetIPv6Filters(chain string) ([]gotik.IPv6FilterRule, error) {
+	m.record("GetIPv6Filters", chain)
+	if m.GetIPv6FiltersFunc == nil {
+		panic("RouterOSMock.GetIPv6FiltersFunc: method is nil but GetIPv6Filters was just called")
+	}
+	return m.GetIPv6FiltersFunc(chain)
+}
+
+// AddIPv6FilterRule calls AddIPv6FilterRuleFunc.
+func (m *RouterOSMock) AddIPv6FilterRule(rule gotik.IPv6FilterRule) (string, error) {
+	m.record("AddIPv6FilterRule", rule)
+	if m.AddIPv6FilterRuleFunc == nil {
+		panic("RouterOSMock.AddIPv6FilterRuleFunc: method is nil but AddIPv6FilterRule was just called")
+	}
+	return m.AddIPv6FilterRuleFunc(rule)
+}
+
+// RemoveIPv6FilterRule calls RemoveIPv6FilterRuleFunc.
+func (m *RouterOSMock) RemoveIPv6FilterRule(id string) error {
+	m.record("RemoveIPv6FilterRule", id)
+	if m.RemoveIPv6FilterRuleFunc == nil {
+		panic("RouterOSMock.RemoveIPv6FilterRuleFunc: method is nil but RemoveIPv6FilterRule was just called")
+	}
+	return m.RemoveIPv6FilterRuleFunc(id)
+}
+
+// EnableIPv6FilterRule calls EnableIPv6FilterRuleFunc.
+func (m *RouterOSMock) EnableIPv6FilterRule(id string) error {
+	m.record("EnableIPv6FilterRule", id)
+	if m.EnableIPv6FilterRuleFunc == nil {
+		panic("RouterOSMock.EnableIPv6FilterRuleFunc: method is nil but EnableIPv6FilterRule was just called")
+	}
+	return m.EnableIPv6FilterRuleFunc(id)
+}
+
+// DisableIPv6FilterRule calls DisableIPv6FilterRuleFunc.
+func (m *RouterOSMock) DisableIPv6FilterRule(id string) error {
+	m.record("DisableIPv6FilterRule", id)
+	if m.DisableIPv6FilterRuleFunc == nil {
+		panic("RouterOSMock.DisableIPv6FilterRuleFunc: method is nil but DisableIPv6FilterRule was just called")
+	}
+	return m.DisableIPv6FilterRuleFunc(id)
+}
+
+// GetIPv6Nat calls GetIPv6NatFunc.
+func (m *RouterOSMock) GetIPv6Nat(chain string) ([]gotik.IPv6NatRule, error) {
+	m.record("GetIPv6Nat", chain)
+	if m.GetIPv6NatFunc == nil {
+		panic("RouterOSMock.GetIPv6NatFunc: method is nil but GetIPv6Nat was just called")
+	}
+	return m.GetIPv6NatFunc(chain)
+}
+
+// AddIPv6NatRule calls AddIPv6NatRuleFunc.
+func (m *RouterOSMock) AddIPv6NatRule(rule gotik.IPv6NatRule) (string, error) {
+	m.record("AddIPv6NatRule", rule)
+	if m.AddIPv6NatRuleFunc == nil {
+		panic("RouterOSMock.AddIPv6NatRuleFunc: method is nil but AddIPv6NatRule was just called")
+	}
+	return m.AddIPv6NatRuleFunc(rule)
+}
+
+// RemoveIPv6NatRule calls RemoveIPv6NatRuleFunc.
+func (m *RouterOSMock) RemoveIPv6NatRule(id string) error {
+	m.record("RemoveIPv6NatRule", id)
+	if m.RemoveIPv6NatRuleFunc == nil {
+		panic("RouterOSMock.RemoveIPv6NatRuleFunc: method is nil but RemoveIPv6NatRule was just called")
+	}
+	return m.RemoveIPv6NatRuleFunc(id)
+}
+
+// EnableIPv6NatRule calls EnableIPv6NatRuleFunc.
+func (m *RouterOSMock) EnableIPv6NatRule(id string) error {
+	m.record("EnableIPv6NatRule", id)
+	if m.EnableIPv6NatRuleFunc == nil {
+		panic("RouterOSMock.EnableIPv6NatRuleFunc: method is nil but EnableIPv6NatRule was just called")
+	}
+	return m.EnableIPv6NatRuleFunc(id)
+}
+
+// DisableIPv6NatRule calls DisableIPv6NatRuleFunc.
+func (m *RouterOSMock) DisableIPv6NatRule(id string) error {
+	m.record("DisableIPv6NatRule", id)
+	if m.DisableIPv6NatRuleFunc == nil {
+		panic("RouterOSMock.DisableIPv6NatRuleFunc: method is nil but DisableIPv6NatRule was just called")
+	}
+	return m.DisableIPv6NatRuleFunc(id)
+}
+
+// GetIPv6Mangle calls GetIPv6MangleFunc.
+func (m *RouterOSMock) GetIPv6Mangle(chain string) ([]gotik.IPv6MangleRule, error) {
+	m.record("GetIPv6Mangle", chain)
+	if m.GetIPv6MangleFunc == nil {
+		panic("RouterOSMock.GetIPv6MangleFunc: method is nil but GetIPv6Mangle was just called")
+	}
+	return m.GetIPv6MangleFunc(chain)
+}
+
+// AddIPv6MangleRule calls AddIPv6MangleRuleFunc.
+func (m *RouterOSMock) AddIPv6MangleRule(rule gotik.IPv6MangleRule) (string, error) {
+	m.record("AddIPv6MangleRule", rule)
+	if m.AddIPv6MangleRuleFunc == nil {
+		panic("RouterOSMock.AddIPv6MangleRuleFunc: method is nil but AddIPv6MangleRule was just called")
+	}
+	return m.AddIPv6MangleRuleFunc(rule)
+}
+
+// RemoveIPv6MangleRule calls RemoveIPv6MangleRuleFunc.
+func (m *RouterOSMock) RemoveIPv6MangleRule(id string) error {
+	m.record("RemoveIPv6MangleRule", id)
+	if m.RemoveIPv6MangleRuleFunc == nil {
+		panic("RouterOSMock.RemoveIPv6MangleRuleFunc: method is nil but RemoveIPv6MangleRule was just called")
+	}
+	return m.RemoveIPv6MangleRuleFunc(id)
+}
+
+// EnableIPv6MangleRule calls EnableIPv6MangleRuleFunc.
+func (m *RouterOSMock) EnableIPv6MangleRule(id string) error {
+	m.record("EnableIPv6MangleRule", id)
+	if m.EnableIPv6MangleRuleFunc == nil {
+		panic("RouterOSMock.EnableIPv6MangleRuleFunc: method is nil but EnableIPv6MangleRule was just called")
+	}
+	return m.EnableIPv6MangleRuleFunc(id)
+}
+
+// DisableIPv6MangleRule calls DisableIPv6MangleRuleFunc.
+func (m *RouterOSMock) DisableIPv6MangleRule(id string) error {
+	m.record("DisableIPv6MangleRule", id)
+	if m.DisableIPv6MangleRuleFunc == nil {
+		panic("RouterOSMock.DisableIPv6MangleRuleFunc: method is nil but DisableIPv6MangleRule was just called")
+	}
+	return m.DisableIPv6MangleRuleFunc(id)
+}
+
+// GetIPv6Raw calls GetIPv6RawFunc.
+func (m *RouterOSMock) GetIPv6Raw(chain string) ([]gotik.IPv6RawRule, error) {
+	m.record("GetIPv6Raw", chain)
+	if m.GetIPv6RawFunc == nil {
+		panic("RouterOSMock.GetIPv6RawFunc: method is nil but GetIPv6Raw was just called")
+	}
+	return m.GetIPv6RawFunc(chain)
+}
+
+// AddIPv6RawRule calls AddIPv6RawRuleFunc.
+func (m *RouterOSMock) AddIPv6RawRule(rule gotik.IPv6RawRule) (string, error) {
+	m.record("AddIPv6RawRule", rule)
+	if m.AddIPv6RawRuleFunc == nil {
+		panic("RouterOSMock.AddIPv6RawRuleFunc: method is nil but AddIPv6RawRule was just called")
+	}
+	return m.AddIPv6RawRuleFunc(rule)
+}
+
+// RemoveIPv6RawRule calls RemoveIPv6RawRuleFunc.
+func (m *RouterOSMock) RemoveIPv6RawRule(id string) error {
+	m.record("RemoveIPv6RawRule", id)
+	if m.RemoveIPv6RawRuleFunc == nil {
+		panic("RouterOSMock.RemoveIPv6RawRuleFunc: method is nil but RemoveIPv6RawRule was just called")
+	}
+	return m.RemoveIPv6RawRuleFunc(id)
+}
+
+// EnableIPv6RawRule calls EnableIPv6RawRuleFunc.
+func (m *RouterOSMock) EnableIPv6RawRule(id string) error {
+	m.record("EnableIPv6RawRule", id)
+	if m.EnableIPv6RawRuleFunc == nil {
+		panic("RouterOSMock.EnableIPv6RawRuleFunc: method is nil but EnableIPv6RawRule was just called")
+	}
+	return m.EnableIPv6RawRuleFunc(id)
+}
+
+// DisableIPv6RawRule calls DisableIPv6RawRuleFunc.
+func (m *RouterOSMock) DisableIPv6RawRule(id string) error {
+	m.record("DisableIPv6RawRule", id)
+	if m.DisableIPv6RawRuleFunc == nil {
+		panic("RouterOSMock.DisableIPv6RawRuleFunc: method is nil but DisableIPv6RawRule was just called")
+	}
+	return m.DisableIPv6RawRuleFunc(id)
+}
+
+// GetIDS calls GetIDSFunc.
+func (m *RouterOSMock) GetIDS(in interface{}) ([]string, string, error) {
+	m.record("GetIDS", in)
+	if m.GetIDSFunc == nil {
+		panic("RouterOSMock.GetIDSFunc: method is nil but GetIDS was just called")
+	}
+	return m.GetIDSFunc(in)
+}
+
+// CommitRule calls CommitRuleFunc.
+func (m *RouterOSMock) CommitRule(in interface{}) error {
+	m.record("CommitRule", in)
+	if m.CommitRuleFunc == nil {
+		panic("RouterOSMock.CommitRuleFunc: method is nil but CommitRule was just called")
+	}
+	return m.CommitRuleFunc(in)
+}
+
+// AddRule calls AddRuleFunc.
+func (m *RouterOSMock) AddRule(in interface{}) error {
+	m.record("AddRule", in)
+	if m.AddRuleFunc == nil {
+		panic("RouterOSMock.AddRuleFunc: method is nil but AddRule was just called")
+	}
+	return m.AddRuleFunc(in)
+}
+
+// RemoveRule calls RemoveRuleFunc.
+func (m *RouterOSMock) RemoveRule(in interface{}) error {
+	m.record("RemoveRule", in)
+	if m.RemoveRuleFunc == nil {
+		panic("RouterOSMock.RemoveRuleFunc: method is nil but RemoveRule was just called")
+	}
+	return m.RemoveRuleFunc(in)
+}
+
+// RemoveRuleByID calls RemoveRuleByIDFunc.
+func (m *RouterOSMock) RemoveRuleByID(in interface{}) error {
+	m.record("RemoveRuleByID", in)
+	if m.RemoveRuleByIDFunc == nil {
+		panic("RouterOSMock.RemoveRuleByIDFunc: method is nil but RemoveRuleByID was just called")
+	}
+	return m.RemoveRuleByIDFunc(in)
+}
+
+// ModifyRule calls ModifyRuleFunc.
+func (m *RouterOSMock) ModifyRule(in interface{}, action string) error {
+	m.record("ModifyRule", in, action)
+	if m.ModifyRuleFunc == nil {
+		panic("RouterOSMock.ModifyRuleFunc: method is nil but ModifyRule was just called")
+	}
+	return m.ModifyRuleFunc(in, action)
+}
+
+// MoveRule calls MoveRuleFunc.
+func (m *RouterOSMock) MoveRule(in interface{}, before string) error {
+	m.record("MoveRule", in, before)
+	if m.MoveRuleFunc == nil {
+		panic("RouterOSMock.MoveRuleFunc: method is nil but MoveRule was just called")
+	}
+	return m.MoveRuleFunc(in, before)
+}
+
+// RuleIsDisabled calls RuleIsDisabledFunc.
+func (m *RouterOSMock) RuleIsDisabled(in interface{}) (bool, error) {
+	m.record("RuleIsDisabled", in)
+	if m.RuleIsDisabledFunc == nil {
+		panic("RouterOSMock.RuleIsDisabledFunc: method is nil but RuleIsDisabled was just called")
+	}
+	return m.RuleIsDisabledFunc(in)
+}
+
+// AddObject calls AddObjectFunc.
+func (m *RouterOSMock) AddObject(in interface{}) error {
+	m.record("AddObject", in)
+	if m.AddObjectFunc == nil {
+		panic("RouterOSMock.AddObjectFunc: method is nil but AddObject was just called")
+	}
+	return m.AddObjectFunc(in)
+}
+
+// GetIPv4AddressList calls GetIPv4AddressListFunc.
+func (m *RouterOSMock) GetIPv4AddressList(listname string) ([]gotik.AddressList, error) {
+	m.record("GetIPv4AddressList", listname)
+	if m.GetIPv4AddressListFunc == nil {
+		panic("RouterOSMock.GetIPv4AddressListFunc: method is nil but GetIPv4AddressList was just called")
+	}
+	return m.GetIPv4AddressListFunc(listname)
+}
+
+// GetIPv6AddressList calls GetIPv6AddressListFunc.
+func (m *RouterOSMock) GetIPv6AddressList(listname string) ([]gotik.AddressList, error) {
+	m.record("GetIPv6AddressList", listname)
+	if m.GetIPv6AddressListFunc == nil {
+		panic("RouterOSMock.GetIPv6AddressListFunc: method is nil but GetIPv6AddressList was just called")
+	}
+	return m.GetIPv6AddressListFunc(listname)
+}
+
+// AuditIPv4AddressList calls AuditIPv4AddressListFunc.
+func (m *RouterOSMock) AuditIPv4AddressList(listname string, list []gotik.AddressList, goodList map[string]string, applyAudits bool) ([]gotik.AddressListAudit, error) {
+	m.record("AuditIPv4AddressList", listname, list, goodList, applyAudits)
+	if m.AuditIPv4AddressListFunc == nil {
+		panic("RouterOSMock.AuditIPv4AddressListFunc: method is nil but AuditIPv4AddressList was just called")
+	}
+	return m.AuditIPv4AddressListFunc(listname, list, goodList, applyAudits)
+}
+
+// AuditIPv6AddressList calls AuditIPv6AddressListFunc.
+func (m *RouterOSMock) AuditIPv6AddressList(listname string, list []gotik.AddressList, goodList map[string]string, applyAudits bool) ([]gotik.AddressListAudit, error) {
+	m.record("AuditIPv6AddressList", listname, list, goodList, applyAudits)
+	if m.AuditIPv6AddressListFunc == nil {
+		panic("RouterOSMock.AuditIPv6AddressListFunc: method is nil but AuditIPv6AddressList was just called")
+	}
+	return m.AuditIPv6AddressListFunc(listname, list, goodList, applyAudits)
+}
+
+// GetConnections calls GetConnectionsFunc.
+func (m *RouterOSMock) GetConnections(f gotik.ConnectionFilter) ([]gotik.Connection, error) {
+	m.record("GetConnections", f)
+	if m.GetConnectionsFunc == nil {
+		panic("RouterOSMock.GetConnectionsFunc: method is nil but GetConnections was just called")
+	}
+	return m.GetConnectionsFunc(f)
+}
+
+// ListenConnections calls ListenConnectionsFunc.
+func (m *RouterOSMock) ListenConnections(f gotik.ConnectionFilter, fn func(arg0 gotik.Connection) error) error {
+	m.record("ListenConnections", f, fn)
+	if m.ListenConnectionsFunc == nil {
+		panic("RouterOSMock.ListenConnectionsFunc: method is nil but ListenConnections was just called")
+	}
+	return m.ListenConnectionsFunc(f, fn)
+}
+
+// RemoveConnections calls RemoveConnectionsFunc.
+func (m *RouterOSMock) RemoveConnections(f gotik.ConnectionFilter) (int, error) {
+	m.record("RemoveConnections", f)
+	if m.RemoveConnectionsFunc == nil {
+		panic("RouterOSMock.RemoveConnectionsFunc: method is nil but RemoveConnections was just called")
+	}
+	return m.RemoveConnectionsFunc(f)
+}
+
+// GetConnectionTracking calls GetConnectionTrackingFunc.
+func (m *RouterOSMock) GetConnectionTracking() (gotik.ConnectionTracking, error) {
+	m.record("GetConnectionTracking")
+	if m.GetConnectionTrackingFunc == nil {
+		panic("RouterOSMock.GetConnectionTrackingFunc: method is nil but GetConnectionTracking was just called")
+	}
+	return m.GetConnectionTrackingFunc()
+}
+
+// SetConnectionTracking calls SetConnectionTrackingFunc.
+func (m *RouterOSMock) SetConnectionTracking(settings gotik.ConnectionTracking) error {
+	m.record("SetConnectionTracking", settings)
+	if m.SetConnectionTrackingFunc == nil {
+		panic("RouterOSMock.SetConnectionTrackingFunc: method is nil but SetConnectionTracking was just called")
+	}
+	return m.SetConnectionTrackingFunc(settings)
+}
+
+// GetInterfacesOfTypes calls GetInterfacesOfTypesFunc.
+func (m *RouterOSMock) GetInterfacesOfTypes(types ...string) ([]gotik.Interface, error) {
+	m.record("GetInterfacesOfTypes", types)
+	if m.GetInterfacesOfTypesFunc == nil {
+		panic("RouterOSMock.GetInterfacesOfTypesFunc: method is nil but GetInterfacesOfTypes was just called")
+	}
+	return m.GetInterfacesOfTypesFunc(types...)
+}
+
+// GetEthInterfaces calls GetEthInterfacesFunc.
+func (m *RouterOSMock) GetEthInterfaces() ([]gotik.Interface, error) {
+	m.record("GetEthInterfaces")
+	if m.GetEthInterfacesFunc == nil {
+		panic("RouterOSMock.GetEthInterfacesFunc: method is nil but GetEthInterfaces was just called")
+	}
+	return m.GetEthInterfacesFunc()
+}
+
+// GetBridgeInterfaces calls GetBridgeInterfacesFunc.
+func (m *RouterOSMock) GetBridgeInterfaces() ([]gotik.Interface, error) {
+	m.record("GetBridgeInterfaces")
+	if m.GetBridgeInterfacesFunc == nil {
+		panic("RouterOSMock.GetBridgeInterfacesFunc: method is nil but GetBridgeInterfaces was just called")
+	}
+	return m.GetBridgeInterfacesFunc()
+}
+
+// GetVlanInterfaces calls GetVlanInterfacesFunc.
+func (m *RouterOSMock) GetVlanInterfaces(baseIntf string) ([]gotik.Interface, error) {
+	m.record("GetVlanInterfaces", baseIntf)
+	if m.GetVlanInterfacesFunc == nil {
+		panic("RouterOSMock.GetVlanInterfacesFunc: method is nil but GetVlanInterfaces was just called")
+	}
+	return m.GetVlanInterfacesFunc(baseIntf)
+}
+
+// GetVLANInterface calls GetVLANInterfaceFunc.
+func (m *RouterOSMock) GetVLANInterface(vlan int) (gotik.Interface, error) {
+	m.record("GetVLANInterface", vlan)
+	if m.GetVLANInterfaceFunc == nil {
+		panic("RouterOSMock.GetVLANInterfaceFunc: method is nil but GetVLANInterface was just called")
+	}
+	return m.GetVLANInterfaceFunc(vlan)
+}
+
+// GetVLANInterfaceOnBase calls GetVLANInterfaceOnBaseFunc.
+func (m *RouterOSMock) GetVLANInterfaceOnBase(baseIntf string, vlan int) (gotik.Interface, error) {
+	m.record("GetVLANInterfaceOnBase", baseIntf, vlan)
+	if m.GetVLANInterfaceOnBaseFunc == nil {
+		panic("RouterOSMock.GetVLANInterfaceOnBaseFunc: method is nil but GetVLANInterfaceOnBase was just called")
+	}
+	return m.GetVLANInterfaceOnBaseFunc(baseIntf, vlan)
+}
+
+// AddVLANInterface calls AddVLANInterfaceFunc.
+func (m *RouterOSMock) AddVLANInterface(intf gotik.Interface) (string, error) {
+	m.record("AddVLANInterface", intf)
+	if m.AddVLANInterfaceFunc == nil {
+		panic("RouterOSMock.AddVLANInterfaceFunc: method is nil but AddVLANInterface was just called")
+	}
+	return m.AddVLANInterfaceFunc(intf)
+}
+
+// EnableInterface calls EnableInterfaceFunc.
+func (m *RouterOSMock) EnableInterface(id string) error {
+	m.record("EnableInterface", id)
+	if m.EnableInterfaceFunc == nil {
+		panic("RouterOSMock.EnableInterfaceFunc: method is nil but EnableInterface was just called")
+	}
+	return m.EnableInterfaceFunc(id)
+}
+
+// DisableInterface calls DisableInterfaceFunc.
+func (m *RouterOSMock) DisableInterface(id string) error {
+	m.record("DisableInterface", id)
+	if m.DisableInterfaceFunc == nil {
+		panic("RouterOSMock.DisableInterfaceFunc: method is nil but DisableInterface was just called")
+	}
+	return m.DisableInterfaceFunc(id)
+}
+
+// SetInterfaceComment calls SetInterfaceCommentFunc.
+func (m *RouterOSMock) SetInterfaceComment(id string, comment string) error {
+	m.record("SetInterfaceComment", id, comment)
+	if m.SetInterfaceCommentFunc == nil {
+		panic("RouterOSMock.SetInterfaceCommentFunc: method is nil but SetInterfaceComment was just called")
+	}
+	return m.SetInterfaceCommentFunc(id, comment)
+}
+
+// SetInterfaceName calls SetInterfaceNameFunc.
+func (m *RouterOSMock) SetInterfaceName(id string, newName string) error {
+	m.record("SetInterfaceName", id, newName)
+	if m.SetInterfaceNameFunc == nil {
+		panic("RouterOSMock.SetInterfaceNameFunc: method is nil but SetInterfaceName was just called")
+	}
+	return m.SetInterfaceNameFunc(id, newName)
+}
+
+// GetArpTable calls GetArpTableFunc.
+func (m *RouterOSMock) GetArpTable() ([]gotik.ArpEntry, error) {
+	m.record("GetArpTable")
+	if m.GetArpTableFunc == nil {
+		panic("RouterOSMock.GetArpTableFunc: method is nil but GetArpTable was just called")
+	}
+	return m.GetArpTableFunc()
+}
+
+// GetInterfaceArpTable calls GetInterfaceArpTableFunc.
+func (m *RouterOSMock) GetInterfaceArpTable(baseIntf string) ([]gotik.ArpEntry, error) {
+	m.record("GetInterfaceArpTable", baseIntf)
+	if m.GetInterfaceArpTableFunc == nil {
+		panic("RouterOSMock.GetInterfaceArpTableFunc: method is nil but GetInterfaceArpTable was just called")
+	}
+	return m.GetInterfaceArpTableFunc(baseIntf)
+}
+
+// ArpLookupByIP calls ArpLookupByIPFunc.
+func (m *RouterOSMock) ArpLookupByIP(ipv4 string) (gotik.ArpEntry, error) {
+	m.record("ArpLookupByIP", ipv4)
+	if m.ArpLookupByIPFunc == nil {
+		panic("RouterOSMock.ArpLookupByIPFunc: method is nil but ArpLookupByIP was just called")
+	}
+	return m.ArpLookupByIPFunc(ipv4)
+}
+
+// ArpLookupByMAC calls ArpLookupByMACFunc.
+func (m *RouterOSMock) ArpLookupByMAC(mac string) (gotik.ArpEntry, error) {
+	m.record("ArpLookupByMAC", mac)
+	if m.ArpLookupByMACFunc == nil {
+		panic("RouterOSMock.ArpLookupByMACFunc: method is nil but ArpLookupByMAC was just called")
+	}
+	return m.ArpLookupByMACFunc(mac)
+}
+
+// GetNeighborInterface calls GetNeighborInterfaceFunc.
+func (m *RouterOSMock) GetNeighborInterface(iface string) (gotik.NeighborInterface, error) {
+	m.record("GetNeighborInterface", iface)
+	if m.GetNeighborInterfaceFunc == nil {
+		panic("RouterOSMock.GetNeighborInterfaceFunc: method is nil but GetNeighborInterface was just called")
+	}
+	return m.GetNeighborInterfaceFunc(iface)
+}
+
+// ModifyNeighbor calls ModifyNeighborFunc.
+func (m *RouterOSMock) ModifyNeighbor(id string, action string) error {
+	m.record("ModifyNeighbor", id, action)
+	if m.ModifyNeighborFunc == nil {
+		panic("RouterOSMock.ModifyNeighborFunc: method is nil but ModifyNeighbor was just called")
+	}
+	return m.ModifyNeighborFunc(id, action)
+}
+
+// GetIPv4Table calls GetIPv4TableFunc.
+func (m *RouterOSMock) GetIPv4Table() ([]gotik.IPv4Address, error) {
+	m.record("GetIPv4Table")
+	if m.GetIPv4TableFunc == nil {
+		panic("RouterOSMock.GetIPv4TableFunc: method is nil but GetIPv4Table was just called")
+	}
+	return m.GetIPv4TableFunc()
+}
+
+// GetInterfaceIPv4Table calls GetInterfaceIPv4TableFunc.
+func (m *RouterOSMock) GetInterfaceIPv4Table(baseIntf string) ([]gotik.IPv4Address, error) {
+	m.record("GetInterfaceIPv4Table", baseIntf)
+	if m.GetInterfaceIPv4TableFunc == nil {
+		panic("RouterOSMock.GetInterfaceIPv4TableFunc: method is nil but GetInterfaceIPv4Table was just called")
+	}
+	return m.GetInterfaceIPv4TableFunc(baseIntf)
+}
+
+// GetCustomerIPv4Subnets calls GetCustomerIPv4SubnetsFunc.
+func (m *RouterOSMock) GetCustomerIPv4Subnets(vlan int) ([]gotik.IPv4Address, error) {
+	m.record("GetCustomerIPv4Subnets", vlan)
+	if m.GetCustomerIPv4SubnetsFunc == nil {
+		panic("RouterOSMock.GetCustomerIPv4SubnetsFunc: method is nil but GetCustomerIPv4Subnets was just called")
+	}
+	return m.GetCustomerIPv4SubnetsFunc(vlan)
+}
+
+// AddIPv4Address calls AddIPv4AddressFunc.
+func (m *RouterOSMock) AddIPv4Address(addr gotik.IPv4Address) (string, error) {
+	m.record("AddIPv4Address", addr)
+	if m.AddIPv4AddressFunc == nil {
+		panic("RouterOSMock.AddIPv4AddressFunc: method is nil but AddIPv4Address was just called")
+	}
+	return m.AddIPv4AddressFunc(addr)
+}
+
+// ModifyIPv4Address calls ModifyIPv4AddressFunc.
+func (m *RouterOSMock) ModifyIPv4Address(id string, action string) error {
+	m.record("ModifyIPv4Address", id, action)
+	if m.ModifyIPv4AddressFunc == nil {
+		panic("RouterOSMock.ModifyIPv4AddressFunc: method is nil but ModifyIPv4Address was just called")
+	}
+	return m.ModifyIPv4AddressFunc(id, action)
+}
+
+// GetIPv4Pools calls GetIPv4PoolsFunc.
+func (m *RouterOSMock) GetIPv4Pools() ([]gotik.IPv4Pool, error) {
+	m.record("GetIPv4Pools")
+	if m.GetIPv4PoolsFunc == nil {
+		panic("RouterOSMock.GetIPv4PoolsFunc: method is nil but GetIPv4Pools was just called")
+	}
+	return m.GetIPv4PoolsFunc()
+}
+
+// GetIPv4Pool calls GetIPv4PoolFunc.
+func (m *RouterOSMock) GetIPv4Pool(name string) ([]gotik.IPv4Pool, error) {
+	m.record("GetIPv4Pool", name)
+	if m.GetIPv4PoolFunc == nil {
+		panic("RouterOSMock.GetIPv4PoolFunc: method is nil but GetIPv4Pool was just called")
+	}
+	return m.GetIPv4PoolFunc(name)
+}
+
+// AddIPv4Pool calls AddIPv4PoolFunc.
+func (m *RouterOSMock) AddIPv4Pool(pool gotik.IPv4Pool) (string, error) {
+	m.record("AddIPv4Pool", pool)
+	if m.AddIPv4PoolFunc == nil {
+		panic("RouterOSMock.AddIPv4PoolFunc: method is nil but AddIPv4Pool was just called")
+	}
+	return m.AddIPv4PoolFunc(pool)
+}
+
+// GetIPv4Routes calls GetIPv4RoutesFunc.
+func (m *RouterOSMock) GetIPv4Routes(limiters []string) ([]gotik.IPv4Route, error) {
+	m.record("GetIPv4Routes", limiters)
+	if m.GetIPv4RoutesFunc == nil {
+		panic("RouterOSMock.GetIPv4RoutesFunc: method is nil but GetIPv4Routes was just called")
+	}
+	return m.GetIPv4RoutesFunc(limiters)
+}
+
+// FindMatchingIPv4Routes calls FindMatchingIPv4RoutesFunc.
+func (m *RouterOSMock) FindMatchingIPv4Routes(routes []gotik.IPv4Route, netToMatch *net.IPNet) ([]gotik.IPv4Route, error) {
+	m.record("FindMatchingIPv4Routes", routes, netToMatch)
+	if m.FindMatchingIPv4RoutesFunc == nil {
+		panic("RouterOSMock.FindMatchingIPv4RoutesFunc: method is nil but FindMatchingIPv4Routes was just called")
+	}
+	return m.FindMatchingIPv4RoutesFunc(routes, netToMatch)
+}
+
+// ModifyIPv4Route calls ModifyIPv4RouteFunc.
+func (m *RouterOSMock) ModifyIPv4Route(id string, action string) error {
+	m.record("ModifyIPv4Route", id, action)
+	if m.ModifyIPv4RouteFunc == nil {
+		panic("RouterOSMock.ModifyIPv4RouteFunc: method is nil but ModifyIPv4Route was just called")
+	}
+	return m.ModifyIPv4RouteFunc(id, action)
+}
+
+// GetIPv6Settings calls GetIPv6SettingsFunc.
+func (m *RouterOSMock) GetIPv6Settings() (gotik.IPv6Settings, error) {
+	m.record("GetIPv6Settings")
+	if m.GetIPv6SettingsFunc == nil {
+		panic("RouterOSMock.GetIPv6SettingsFunc: method is nil but GetIPv6Settings was just called")
+	}
+	return m.GetIPv6SettingsFunc()
+}
+
+// GetDhcpv4Networks calls GetDhcpv4NetworksFunc.
+func (m *RouterOSMock) GetDhcpv4Networks() ([]gotik.DHCP4Network, error) {
+	m.record("GetDhcpv4Networks")
+	if m.GetDhcpv4NetworksFunc == nil {
+		panic("RouterOSMock.GetDhcpv4NetworksFunc: method is nil but GetDhcpv4Networks was just called")
+	}
+	return m.GetDhcpv4NetworksFunc()
+}
+
+// AddDhcpv4Network calls AddDhcpv4NetworkFunc.
+func (m *RouterOSMock) AddDhcpv4Network(s gotik.DHCP4Network) (string, error) {
+	m.record("AddDhcpv4Network", s)
+	if m.AddDhcpv4NetworkFunc == nil {
+		panic("RouterOSMock.AddDhcpv4NetworkFunc: method is nil but AddDhcpv4Network was just called")
+	}
+	return m.AddDhcpv4NetworkFunc(s)
+}
+
+// GetDhcpv4Servers calls GetDhcpv4ServersFunc.
+func (m *RouterOSMock) GetDhcpv4Servers() ([]gotik.DHCPv4Server, error) {
+	m.record("GetDhcpv4Servers")
+	if m.GetDhcpv4ServersFunc == nil {
+		panic("RouterOSMock.GetDhcpv4ServersFunc: method is nil but GetDhcpv4Servers was just called")
+	}
+	return m.GetDhcpv4ServersFunc()
+}
+
+// GetDhcp4ServerByName calls GetDhcp4ServerByNameFunc.
+func (m *RouterOSMock) GetDhcp4ServerByName(name string) ([]gotik.DHCPv4Server, error) {
+	m.record("GetDhcp4ServerByName", name)
+	if m.GetDhcp4ServerByNameFunc == nil {
+		panic("RouterOSMock.GetDhcp4ServerByNameFunc: method is nil but GetDhcp4ServerByName was just called")
+	}
+	return m.GetDhcp4ServerByNameFunc(name)
+}
+
+// GetDhcp4ServerByIntf calls GetDhcp4ServerByIntfFunc.
+func (m *RouterOSMock) GetDhcp4ServerByIntf(intf string) ([]gotik.DHCPv4Server, error) {
+	m.record("GetDhcp4ServerByIntf", intf)
+	if m.GetDhcp4ServerByIntfFunc == nil {
+		panic("RouterOSMock.GetDhcp4ServerByIntfFunc: method is nil but GetDhcp4ServerByIntf was just called")
+	}
+	return m.GetDhcp4ServerByIntfFunc(intf)
+}
+
+// AddDhcpv4Server calls AddDhcpv4ServerFunc.
+func (m *RouterOSMock) AddDhcpv4Server(s gotik.DHCPv4Server) (string, error) {
+	m.record("AddDhcpv4Server", s)
+	if m.AddDhcpv4ServerFunc == nil {
+		panic("RouterOSMock.AddDhcpv4ServerFunc: method is nil but AddDhcpv4Server was just called")
+	}
+	return m.AddDhcpv4ServerFunc(s)
+}
+
+// SetDhcpv4ServerDisable calls SetDhcpv4ServerDisableFunc.
+func (m *RouterOSMock) SetDhcpv4ServerDisable(id string, disabled bool) error {
+	m.record("SetDhcpv4ServerDisable", id, disabled)
+	if m.SetDhcpv4ServerDisableFunc == nil {
+		panic("RouterOSMock.SetDhcpv4ServerDisableFunc: method is nil but SetDhcpv4ServerDisable was just called")
+	}
+	return m.SetDhcpv4ServerDisableFunc(id, disabled)
+}
+
+// GetDNS calls GetDNSFunc.
+func (m *RouterOSMock) GetDNS() (gotik.DNS, error) {
+	m.record("GetDNS")
+	if m.GetDNSFunc == nil {
+		panic("RouterOSMock.GetDNSFunc: method is nil but GetDNS was just called")
+	}
+	return m.GetDNSFunc()
+}
+
+// SetDNS calls SetDNSFunc.
+func (m *RouterOSMock) SetDNS(d gotik.DNS) error {
+	m.record("SetDNS", d)
+	if m.SetDNSFunc == nil {
+		panic("RouterOSMock.SetDNSFunc: method is nil but SetDNS was just called")
+	}
+	return m.SetDNSFunc(d)
+}
+
+// FlushDNS calls FlushDNSFunc.
+func (m *RouterOSMock) FlushDNS() error {
+	m.record("FlushDNS")
+	if m.FlushDNSFunc == nil {
+		panic("RouterOSMock.FlushDNSFunc: method is nil but FlushDNS was just called")
+	}
+	return m.FlushDNSFunc()
+}
+
+// GetIPServices calls GetIPServicesFunc.
+func (m *RouterOSMock) GetIPServices() ([]gotik.IPService, error) {
+	m.record("GetIPServices")
+	if m.GetIPServicesFunc == nil {
+		panic("RouterOSMock.GetIPServicesFunc: method is nil but GetIPServices was just called")
+	}
+	return m.GetIPServicesFunc()
+}
+
+// SetIPService calls SetIPServiceFunc.
+func (m *RouterOSMock) SetIPService(id string, disabled bool, port int, address string, cert string, tlsVersion string) error {
+	m.record("SetIPService", id, disabled, port, address, cert, tlsVersion)
+	if m.SetIPServiceFunc == nil {
+		panic("RouterOSMock.SetIPServiceFunc: method is nil but SetIPService was just called")
+	}
+	return m.SetIPServiceFunc(id, disabled, port, address, cert, tlsVersion)
+}
+
+// SetIPServiceDisable calls SetIPServiceDisableFunc.
+func (m *RouterOSMock) SetIPServiceDisable(id string, disabled bool) error {
+	m.record("SetIPServiceDisable", id, disabled)
+	if m.SetIPServiceDisableFunc == nil {
+		panic("RouterOSMock.SetIPServiceDisableFunc: method is nil but SetIPServiceDisable was just called")
+	}
+	return m.SetIPServiceDisableFunc(id, disabled)
+}
+
+// GetPPPSecrets calls GetPPPSecretsFunc.
+func (m *RouterOSMock) GetPPPSecrets() ([]gotik.PPPSecret, error) {
+	m.record("GetPPPSecrets")
+	if m.GetPPPSecretsFunc == nil {
+		panic("RouterOSMock.GetPPPSecretsFunc: method is nil but GetPPPSecrets was just called")
+	}
+	return m.GetPPPSecretsFunc()
+}
+
+// GetPPPSecretByName calls GetPPPSecretByNameFunc.
+func (m *RouterOSMock) GetPPPSecretByName(name string) (gotik.PPPSecret, error) {
+	m.record("GetPPPSecretByName", name)
+	if m.GetPPPSecretByNameFunc == nil {
+		panic("RouterOSMock.GetPPPSecretByNameFunc: method is nil but GetPPPSecretByName was just called")
+	}
+	return m.GetPPPSecretByNameFunc(name)
+}
+
+// AddPPPSecret calls AddPPPSecretFunc.
+func (m *RouterOSMock) AddPPPSecret(secret gotik.PPPSecret) (string, error) {
+	m.record("AddPPPSecret", secret)
+	if m.AddPPPSecretFunc == nil {
+		panic("RouterOSMock.AddPPPSecretFunc: method is nil but AddPPPSecret was just called")
+	}
+	return m.AddPPPSecretFunc(secret)
+}
+
+// UpdatePPPSecret calls UpdatePPPSecretFunc.
+func (m *RouterOSMock) UpdatePPPSecret(secret gotik.PPPSecret) (string, error) {
+	m.record("UpdatePPPSecret", secret)
+	if m.UpdatePPPSecretFunc == nil {
+		panic("RouterOSMock.UpdatePPPSecretFunc: method is nil but UpdatePPPSecret was just called")
+	}
+	return m.UpdatePPPSecretFunc(secret)
+}
+
+// RemovePPPSecret calls RemovePPPSecretFunc.
+func (m *RouterOSMock) RemovePPPSecret(id string) error {
+	m.record("RemovePPPSecret", id)
+	if m.RemovePPPSecretFunc == nil {
+		panic("RouterOSMock.RemovePPPSecretFunc: method is nil but RemovePPPSecret was just called")
+	}
+	return m.RemovePPPSecretFunc(id)
+}
+
+// RemovePPPSecretByName calls RemovePPPSecretByNameFunc.
+func (m *RouterOSMock) RemovePPPSecretByName(name string) error {
+	m.record("RemovePPPSecretByName", name)
+	if m.RemovePPPSecretByNameFunc == nil {
+		panic("RouterOSMock.RemovePPPSecretByNameFunc: method is nil but RemovePPPSecretByName was just called")
+	}
+	return m.RemovePPPSecretByNameFunc(name)
+}
+
+// GetPPPActiveConnections calls GetPPPActiveConnectionsFunc.
+func (m *RouterOSMock) GetPPPActiveConnections() ([]gotik.PPPActive, error) {
+	m.record("GetPPPActiveConnections")
+	if m.GetPPPActiveConnectionsFunc == nil {
+		panic("RouterOSMock.GetPPPActiveConnectionsFunc: method is nil but GetPPPActiveConnections was just called")
+	}
+	return m.GetPPPActiveConnectionsFunc()
+}
+
+// GetPPPActiveConnectionByName calls GetPPPActiveConnectionByNameFunc.
+func (m *RouterOSMock) GetPPPActiveConnectionByName(name string) (gotik.PPPActive, error) {
+	m.record("GetPPPActiveConnectionByName", name)
+	if m.GetPPPActiveConnectionByNameFunc == nil {
+		panic("RouterOSMock.GetPPPActiveConnectionByNameFunc: method is nil but GetPPPActiveConnectionByName was just called")
+	}
+	return m.GetPPPActiveConnectionByNameFunc(name)
+}
+
+// GetPPPoEServers calls GetPPPoEServersFunc.
+func (m *RouterOSMock) GetPPPoEServers(intf string) ([]gotik.PPPoEServer, error) {
+	m.record("GetPPPoEServers", intf)
+	if m.GetPPPoEServersFunc == nil {
+		panic("RouterOSMock.GetPPPoEServersFunc: method is nil but GetPPPoEServers was just called")
+	}
+	return m.GetPPPoEServersFunc(intf)
+}
+
+// RemovePPPoEServer calls RemovePPPoEServerFunc.
+func (m *RouterOSMock) RemovePPPoEServer(id string) error {
+	m.record("RemovePPPoEServer", id)
+	if m.RemovePPPoEServerFunc == nil {
+		panic("RouterOSMock.RemovePPPoEServerFunc: method is nil but RemovePPPoEServer was just called")
+	}
+	return m.RemovePPPoEServerFunc(id)
+}
+
+// GetQueueTreeByName calls GetQueueTreeByNameFunc.
+func (m *RouterOSMock) GetQueueTreeByName(name string) (gotik.QueueTree, error) {
+	m.record("GetQueueTreeByName", name)
+	if m.GetQueueTreeByNameFunc == nil {
+		panic("RouterOSMock.GetQueueTreeByNameFunc: method is nil but GetQueueTreeByName was just called")
+	}
+	return m.GetQueueTreeByNameFunc(name)
+}
+
+// GetQueueTree calls GetQueueTreeFunc.
+func (m *RouterOSMock) GetQueueTree(parent string) ([]gotik.QueueTree, error) {
+	m.record("GetQueueTree", parent)
+	if m.GetQueueTreeFunc == nil {
+		panic("RouterOSMock.GetQueueTreeFunc: method is nil but GetQueueTree was just called")
+	}
+	return m.GetQueueTreeFunc(parent)
+}
+
+// GetQueueTreeAll calls GetQueueTreeAllFunc.
+func (m *RouterOSMock) GetQueueTreeAll() ([]gotik.QueueTree, error) {
+	m.record("GetQueueTreeAll")
+	if m.GetQueueTreeAllFunc == nil {
+		panic("RouterOSMock.GetQueueTreeAllFunc: method is nil but GetQueueTreeAll was just called")
+	}
+	return m.GetQueueTreeAllFunc()
+}
+
+// AddQueueTree calls AddQueueTreeFunc.
+func (m *RouterOSMock) AddQueueTree(queue *gotik.QueueTree) error {
+	m.record("AddQueueTree", queue)
+	if m.AddQueueTreeFunc == nil {
+		panic("RouterOSMock.AddQueueTreeFunc: method is nil but AddQueueTree was just called")
+	}
+	return m.AddQueueTreeFunc(queue)
+}
+
+// AddQueueTreeSingle calls AddQueueTreeSingleFunc.
+func (m *RouterOSMock) AddQueueTreeSingle(queue gotik.QueueTree) (string, error) {
+	m.record("AddQueueTreeSingle", queue)
+	if m.AddQueueTreeSingleFunc == nil {
+		panic("RouterOSMock.AddQueueTreeSingleFunc: method is nil but AddQueueTreeSingle was just called")
+	}
+	return m.AddQueueTreeSingleFunc(queue)
+}
+
+// RemoveQueueTree calls RemoveQueueTreeFunc.
+func (m *RouterOSMock) RemoveQueueTree(queue gotik.QueueTree, removeChildren bool) error {
+	m.record("RemoveQueueTree", queue, removeChildren)
+	if m.RemoveQueueTreeFunc == nil {
+		panic("RouterOSMock.RemoveQueueTreeFunc: method is nil but RemoveQueueTree was just called")
+	}
+	return m.RemoveQueueTreeFunc(queue, removeChildren)
+}
+
+// RemoveQueueTreeByName calls RemoveQueueTreeByNameFunc.
+func (m *RouterOSMock) RemoveQueueTreeByName(name string) error {
+	m.record("RemoveQueueTreeByName", name)
+	if m.RemoveQueueTreeByNameFunc == nil {
+		panic("RouterOSMock.RemoveQueueTreeByNameFunc: method is nil but RemoveQueueTreeByName was just called")
+	}
+	return m.RemoveQueueTreeByNameFunc(name)
+}
+
+// GetSimpleQueues calls GetSimpleQueuesFunc.
+func (m *RouterOSMock) GetSimpleQueues(target string) ([]gotik.SimpleQueue, error) {
+	m.record("GetSimpleQueues", target)
+	if m.GetSimpleQueuesFunc == nil {
+		panic("RouterOSMock.GetSimpleQueuesFunc: method is nil but GetSimpleQueues was just called")
+	}
+	return m.GetSimpleQueuesFunc(target)
+}
+
+// RemoveSimpleQueue calls RemoveSimpleQueueFunc.
+func (m *RouterOSMock) RemoveSimpleQueue(ID string) error {
+	m.record("RemoveSimpleQueue", ID)
+	if m.RemoveSimpleQueueFunc == nil {
+		panic("RouterOSMock.RemoveSimpleQueueFunc: method is nil but RemoveSimpleQueue was just called")
+	}
+	return m.RemoveSimpleQueueFunc(ID)
+}
+
+// GetOspf2LsaTable calls GetOspf2LsaTableFunc.
+func (m *RouterOSMock) GetOspf2LsaTable() ([]gotik.OSPF2LSA, error) {
+	m.record("GetOspf2LsaTable")
+	if m.GetOspf2LsaTableFunc == nil {
+		panic("RouterOSMock.GetOspf2LsaTableFunc: method is nil but GetOspf2LsaTable was just called")
+	}
+	return m.GetOspf2LsaTableFunc()
+}
+
+// GetSystemResources calls GetSystemResourcesFunc.
+func (m *RouterOSMock) GetSystemResources() (gotik.Resources, error) {
+	m.record("GetSystemResources")
+	if m.GetSystemResourcesFunc == nil {
+		panic("RouterOSMock.GetSystemResourcesFunc: method is nil but GetSystemResources was just called")
+	}
+	return m.GetSystemResourcesFunc()
+}
+
+// GetSystemRouterboard calls GetSystemRouterboardFunc.
+func (m *RouterOSMock) GetSystemRouterboard() (gotik.Routerboard, error) {
+	m.record("GetSystemRouterboard")
+	if m.GetSystemRouterboardFunc == nil {
+		panic("RouterOSMock.GetSystemRouterboardFunc: method is nil but GetSystemRouterboard was just called")
+	}
+	return m.GetSystemRouterboardFunc()
+}
+
+// GetSystemId calls GetSystemIdFunc.
+func (m *RouterOSMock) GetSystemId() (string, error) {
+	m.record("GetSystemId")
+	if m.GetSystemIdFunc == nil {
+		panic("RouterOSMock.GetSystemIdFunc: method is nil but GetSystemId was just called")
+	}
+	return m.GetSystemIdFunc()
+}
+
+// GetSystemLicense calls GetSystemLicenseFunc.
+func (m *RouterOSMock) GetSystemLicense() (gotik.License, error) {
+	m.record("GetSystemLicense")
+	if m.GetSystemLicenseFunc == nil {
+		panic("RouterOSMock.GetSystemLicenseFunc: method is nil but GetSystemLicense was just called")
+	}
+	return m.GetSystemLicenseFunc()
+}
+
+// CreateExport calls CreateExportFunc.
+func (m *RouterOSMock) CreateExport(targetname string, minFreeSpace int) error {
+	m.record("CreateExport", targetname, minFreeSpace)
+	if m.CreateExportFunc == nil {
+		panic("RouterOSMock.CreateExportFunc: method is nil but CreateExport was just called")
+	}
+	return m.CreateExportFunc(targetname, minFreeSpace)
+}
+
+// ExportConfig calls ExportConfigFunc.
+func (m *RouterOSMock) ExportConfig(base string, filename string, hideSensitive bool) error {
+	m.record("ExportConfig", base, filename, hideSensitive)
+	if m.ExportConfigFunc == nil {
+		panic("RouterOSMock.ExportConfigFunc: method is nil but ExportConfig was just called")
+	}
+	return m.ExportConfigFunc(base, filename, hideSensitive)
+}
+
+// ExportConfigToWriter calls ExportConfigToWriterFunc.
+func (m *RouterOSMock) ExportConfigToWriter(base string, w io.Writer, hideSensitive bool) error {
+	m.record("ExportConfigToWriter", base, w, hideSensitive)
+	if m.ExportConfigToWriterFunc == nil {
+		panic("RouterOSMock.ExportConfigToWriterFunc: method is nil but ExportConfigToWriter was just called")
+	}
+	return m.ExportConfigToWriterFunc(base, w, hideSensitive)
+}
+
+// Fetch calls FetchFunc.
+func (m *RouterOSMock) Fetch(filename string, hideSensitive bool) error {
+	m.record("Fetch", filename, hideSensitive)
+	if m.FetchFunc == nil {
+		panic("RouterOSMock.FetchFunc: method is nil but Fetch was just called")
+	}
+	return m.FetchFunc(filename, hideSensitive)
+}
+
+// GetPackages calls GetPackagesFunc.
+func (m *RouterOSMock) GetPackages() ([]gotik.Package, error) {
+	m.record("GetPackages")
+	if m.GetPackagesFunc == nil {
+		panic("RouterOSMock.GetPackagesFunc: method is nil but GetPackages was just called")
+	}
+	return m.GetPackagesFunc()
+}
+
+// IsPackageEnabled calls IsPackageEnabledFunc.
+func (m *RouterOSMock) IsPackageEnabled(name string) (bool, error) {
+	m.record("IsPackageEnabled", name)
+	if m.IsPackageEnabledFunc == nil {
+		panic("RouterOSMock.IsPackageEnabledFunc: method is nil but IsPackageEnabled was just called")
+	}
+	return m.IsPackageEnabledFunc(name)
+}
+
+// GetUpdateInfo calls GetUpdateInfoFunc.
+func (m *RouterOSMock) GetUpdateInfo() (gotik.PackageUpdate, error) {
+	m.record("GetUpdateInfo")
+	if m.GetUpdateInfoFunc == nil {
+		panic("RouterOSMock.GetUpdateInfoFunc: method is nil but GetUpdateInfo was just called")
+	}
+	return m.GetUpdateInfoFunc()
+}
+
+// CheckForUpdates calls CheckForUpdatesFunc.
+func (m *RouterOSMock) CheckForUpdates() (gotik.PackageUpdate, error) {
+	m.record("CheckForUpdates")
+	if m.CheckForUpdatesFunc == nil {
+		panic("RouterOSMock.CheckForUpdatesFunc: method is nil but CheckForUpdates was just called")
+	}
+	return m.CheckForUpdatesFunc()
+}
+
+// SetUpdateChannel calls SetUpdateChannelFunc.
+func (m *RouterOSMock) SetUpdateChannel(channel string) error {
+	m.record("SetUpdateChannel", channel)
+	if m.SetUpdateChannelFunc == nil {
+		panic("RouterOSMock.SetUpdateChannelFunc: method is nil but SetUpdateChannel was just called")
+	}
+	return m.SetUpdateChannelFunc(channel)
+}
+
+// DownloadUpdates calls DownloadUpdatesFunc.
+func (m *RouterOSMock) DownloadUpdates() (gotik.PackageUpdate, error) {
+	m.record("DownloadUpdates")
+	if m.DownloadUpdatesFunc == nil {
+		panic("RouterOSMock.DownloadUpdatesFunc: method is nil but DownloadUpdates was just called")
+	}
+	return m.DownloadUpdatesFunc()
+}
+
+// InstallUpdates calls InstallUpdatesFunc.
+func (m *RouterOSMock) InstallUpdates() (gotik.PackageUpdate, error) {
+	m.record("InstallUpdates")
+	if m.InstallUpdatesFunc == nil {
+		panic("RouterOSMock.InstallUpdatesFunc: method is nil but InstallUpdates was just called")
+	}
+	return m.InstallUpdatesFunc()
+}
+
+// GetUsers calls GetUsersFunc.
+func (m *RouterOSMock) GetUsers() ([]gotik.User, error) {
+	m.record("GetUsers")
+	if m.GetUsersFunc == nil {
+		panic("RouterOSMock.GetUsersFunc: method is nil but GetUsers was just called")
+	}
+	return m.GetUsersFunc()
+}
+
+// GetUserByName calls GetUserByNameFunc.
+func (m *RouterOSMock) GetUserByName(name string) (gotik.User, error) {
+	m.record("GetUserByName", name)
+	if m.GetUserByNameFunc == nil {
+		panic("RouterOSMock.GetUserByNameFunc: method is nil but GetUserByName was just called")
+	}
+	return m.GetUserByNameFunc(name)
+}
+
+// AddUser calls AddUserFunc.
+func (m *RouterOSMock) AddUser(user gotik.User) (string, error) {
+	m.record("AddUser", user)
+	if m.AddUserFunc == nil {
+		panic("RouterOSMock.AddUserFunc: method is nil but AddUser was just called")
+	}
+	return m.AddUserFunc(user)
+}
+
+// UpdateUser calls UpdateUserFunc.
+func (m *RouterOSMock) UpdateUser(user gotik.User) (string, error) {
+	m.record("UpdateUser", user)
+	if m.UpdateUserFunc == nil {
+		panic("RouterOSMock.UpdateUserFunc: method is nil but UpdateUser was just called")
+	}
+	return m.UpdateUserFunc(user)
+}
+
+// UpdateUserPasswordByID calls UpdateUserPasswordByIDFunc.
+func (m *RouterOSMock) UpdateUserPasswordByID(ID string, password string) (string, error) {
+	m.record("UpdateUserPasswordByID", ID, password)
+	if m.UpdateUserPasswordByIDFunc == nil {
+		panic("RouterOSMock.UpdateUserPasswordByIDFunc: method is nil but UpdateUserPasswordByID was just called")
+	}
+	return m.UpdateUserPasswordByIDFunc(ID, password)
+}
+
+// UpdateUserPasswordByName calls UpdateUserPasswordByNameFunc.
+func (m *RouterOSMock) UpdateUserPasswordByName(username string, password string) (string, error) {
+	m.record("UpdateUserPasswordByName", username, password)
+	if m.UpdateUserPasswordByNameFunc == nil {
+		panic("RouterOSMock.UpdateUserPasswordByNameFunc: method is nil but UpdateUserPasswordByName was just called")
+	}
+	return m.UpdateUserPasswordByNameFunc(username, password)
+}
+
+// RemoveUser calls RemoveUserFunc.
+func (m *RouterOSMock) RemoveUser(id string) error {
+	m.record("RemoveUser", id)
+	if m.RemoveUserFunc == nil {
+		panic("RouterOSMock.RemoveUserFunc: method is nil but RemoveUser was just called")
+	}
+	return m.RemoveUserFunc(id)
+}
+
+// RemoveUserByName calls RemoveUserByNameFunc.
+func (m *RouterOSMock) RemoveUserByName(name string) error {
+	m.record("RemoveUserByName", name)
+	if m.RemoveUserByNameFunc == nil {
+		panic("RouterOSMock.RemoveUserByNameFunc: method is nil but RemoveUserByName was just called")
+	}
+	return m.RemoveUserByNameFunc(name)
+}
+
+// GetGroups calls GetGroupsFunc.
+func (m *RouterOSMock) GetGroups() ([]gotik.Group, error) {
+	m.record("GetGroups")
+	if m.GetGroupsFunc == nil {
+		panic("RouterOSMock.GetGroupsFunc: method is nil but GetGroups was just called")
+	}
+	return m.GetGroupsFunc()
+}
+
+// GetGroupByName calls GetGroupByNameFunc.
+func (m *RouterOSMock) GetGroupByName(name string) (gotik.Group, error) {
+	m.record("GetGroupByName", name)
+	if m.GetGroupByNameFunc == nil {
+		panic("RouterOSMock.GetGroupByNameFunc: method is nil but GetGroupByName was just called")
+	}
+	return m.GetGroupByNameFunc(name)
+}
+
+// AddGroup calls AddGroupFunc.
+func (m *RouterOSMock) AddGroup(g gotik.Group) (string, error) {
+	m.record("AddGroup", g)
+	if m.AddGroupFunc == nil {
+		panic("RouterOSMock.AddGroupFunc: method is nil but AddGroup was just called")
+	}
+	return m.AddGroupFunc(g)
+}
+
+// UpdateGroup calls UpdateGroupFunc.
+func (m *RouterOSMock) UpdateGroup(g gotik.Group) (string, error) {
+	m.record("UpdateGroup", g)
+	if m.UpdateGroupFunc == nil {
+		panic("RouterOSMock.UpdateGroupFunc: method is nil but UpdateGroup was just called")
+	}
+	return m.UpdateGroupFunc(g)
+}
+
+// RemoveGroup calls RemoveGroupFunc.
+func (m *RouterOSMock) RemoveGroup(id string) error {
+	m.record("RemoveGroup", id)
+	if m.RemoveGroupFunc == nil {
+		panic("RouterOSMock.RemoveGroupFunc: method is nil but RemoveGroup was just called")
+	}
+	return m.RemoveGroupFunc(id)
+}
+
+// RemoveGroupByName calls RemoveGroupByNameFunc.
+func (m *RouterOSMock) RemoveGroupByName(name string) error {
+	m.record("RemoveGroupByName", name)
+	if m.RemoveGroupByNameFunc == nil {
+		panic("RouterOSMock.RemoveGroupByNameFunc: method is nil but RemoveGroupByName was just called")
+	}
+	return m.RemoveGroupByNameFunc(name)
+}
+
+// GetScripts calls GetScriptsFunc.
+func (m *RouterOSMock) GetScripts() ([]gotik.Script, error) {
+	m.record("GetScripts")
+	if m.GetScriptsFunc == nil {
+		panic("RouterOSMock.GetScriptsFunc: method is nil but GetScripts was just called")
+	}
+	return m.GetScriptsFunc()
+}
+
+// AddScript calls AddScriptFunc.
+func (m *RouterOSMock) AddScript(s gotik.Script) (string, error) {
+	m.record("AddScript", s)
+	if m.AddScriptFunc == nil {
+		panic("RouterOSMock.AddScriptFunc: method is nil but AddScript was just called")
+	}
+	return m.AddScriptFunc(s)
+}
+
+// UpdateScript calls UpdateScriptFunc.
+func (m *RouterOSMock) UpdateScript(s gotik.Script) (string, error) {
+	m.record("UpdateScript", s)
+	if m.UpdateScriptFunc == nil {
+		panic("RouterOSMock.UpdateScriptFunc: method is nil but UpdateScript was just called")
+	}
+	return m.UpdateScriptFunc(s)
+}
+
+// RemoveScript calls RemoveScriptFunc.
+func (m *RouterOSMock) RemoveScript(id string) error {
+	m.record("RemoveScript", id)
+	if m.RemoveScriptFunc == nil {
+		panic("RouterOSMock.RemoveScriptFunc: method is nil but RemoveScript was just called")
+	}
+	return m.RemoveScriptFunc(id)
+}
+
+// GetScheduler calls GetSchedulerFunc.
+func (m *RouterOSMock) GetScheduler() ([]gotik.Schedule, error) {
+	m.record("GetScheduler")
+	if m.GetSchedulerFunc == nil {
+		panic("RouterOSMock.GetSchedulerFunc: method is nil but GetScheduler was just called")
+	}
+	return m.GetSchedulerFunc()
+}
+
+// AddSchedule calls AddScheduleFunc.
+func (m *RouterOSMock) AddSchedule(s gotik.Schedule) (string, error) {
+	m.record("AddSchedule", s)
+	if m.AddScheduleFunc == nil {
+		panic("RouterOSMock.AddScheduleFunc: method is nil but AddSchedule was just called")
+	}
+	return m.AddScheduleFunc(s)
+}
+
+// UpdateSchedule calls UpdateScheduleFunc.
+func (m *RouterOSMock) UpdateSchedule(s gotik.Schedule) (string, error) {
+	m.record("UpdateSchedule", s)
+	if m.UpdateScheduleFunc == nil {
+		panic("RouterOSMock.UpdateScheduleFunc: method is nil but UpdateSchedule was just called")
+	}
+	return m.UpdateScheduleFunc(s)
+}
+
+// RemoveSchedule calls RemoveScheduleFunc.
+func (m *RouterOSMock) RemoveSchedule(id string) error {
+	m.record("RemoveSchedule", id)
+	if m.RemoveScheduleFunc == nil {
+		panic("RouterOSMock.RemoveScheduleFunc: method is nil but RemoveSchedule was just called")
+	}
+	return m.RemoveScheduleFunc(id)
+}
+
+// GetAllFiles calls GetAllFilesFunc.
+func (m *RouterOSMock) GetAllFiles() ([]gotik.File, error) {
+	m.record("GetAllFiles")
+	if m.GetAllFilesFunc == nil {
+		panic("RouterOSMock.GetAllFilesFunc: method is nil but GetAllFiles was just called")
+	}
+	return m.GetAllFilesFunc()
+}
+
+// AddFile calls AddFileFunc.
+func (m *RouterOSMock) AddFile(name string, contents string) error {
+	m.record("AddFile", name, contents)
+	if m.AddFileFunc == nil {
+		panic("RouterOSMock.AddFileFunc: method is nil but AddFile was just called")
+	}
+	return m.AddFileFunc(name, contents)
+}
+
+// RemoveFileByName calls RemoveFileByNameFunc.
+func (m *RouterOSMock) RemoveFileByName(name string) error {
+	m.record("RemoveFileByName", name)
+	if m.RemoveFileByNameFunc == nil {
+		panic("RouterOSMock.RemoveFileByNameFunc: method is nil but RemoveFileByName was just called")
+	}
+	return m.RemoveFileByNameFunc(name)
+}
+
+// RemoveFileByID calls RemoveFileByIDFunc.
+func (m *RouterOSMock) RemoveFileByID(id string) error {
+	m.record("RemoveFileByID", id)
+	if m.RemoveFileByIDFunc == nil {
+		panic("RouterOSMock.RemoveFileByIDFunc: method is nil but RemoveFileByID was just called")
+	}
+	return m.RemoveFileByIDFunc(id)
+}
+
+// ReadFile calls ReadFileFunc.
+func (m *RouterOSMock) ReadFile(name string) ([]byte, error) {
+	m.record("ReadFile", name)
+	if m.ReadFileFunc == nil {
+		panic("RouterOSMock.ReadFileFunc: method is nil but ReadFile was just called")
+	}
+	return m.ReadFileFunc(name)
+}
+
+// DownloadFile calls DownloadFileFunc.
+func (m *RouterOSMock) DownloadFile(name string, w io.Writer) error {
+	m.record("DownloadFile", name, w)
+	if m.DownloadFileFunc == nil {
+		panic("RouterOSMock.DownloadFileFunc: method is nil but DownloadFile was just called")
+	}
+	return m.DownloadFileFunc(name, w)
+}
+
+// UploadFile calls UploadFileFunc.
+func (m *RouterOSMock) UploadFile(name string, r io.Reader) error {
+	m.record("UploadFile", name, r)
+	if m.UploadFileFunc == nil {
+		panic("RouterOSMock.UploadFileFunc: method is nil but UploadFile was just called")
+	}
+	return m.UploadFileFunc(name, r)
+}
+
+// ImportScript calls ImportScriptFunc.
+func (m *RouterOSMock) ImportScript(name string, opts gotik.ImportOptions) (gotik.ImportResult, error) {
+	m.record("ImportScript", name, opts)
+	if m.ImportScriptFunc == nil {
+		panic("RouterOSMock.ImportScriptFunc: method is nil but ImportScript was just called")
+	}
+	return m.ImportScriptFunc(name, opts)
+}
+
+// ImportString calls ImportStringFunc.
+func (m *RouterOSMock) ImportString(script string, opts gotik.ImportOptions) (gotik.ImportResult, error) {
+	m.record("ImportString", script, opts)
+	if m.ImportStringFunc == nil {
+		panic("RouterOSMock.ImportStringFunc: method is nil but ImportString was just called")
+	}
+	return m.ImportStringFunc(script, opts)
+}
+
+// GetCertificates calls GetCertificatesFunc.
+func (m *RouterOSMock) GetCertificates() ([]gotik.Certificate, error) {
+	m.record("GetCertificates")
+	if m.GetCertificatesFunc == nil {
+		panic("RouterOSMock.GetCertificatesFunc: method is nil but GetCertificates was just called")
+	}
+	return m.GetCertificatesFunc()
+}
+
+// CertificateImport calls CertificateImportFunc.
+func (m *RouterOSMock) CertificateImport(name string, filename string, passphrase string) (gotik.CertImportResults, error) {
+	m.record("CertificateImport", name, filename, passphrase)
+	if m.CertificateImportFunc == nil {
+		panic("RouterOSMock.CertificateImportFunc: method is nil but CertificateImport was just called")
+	}
+	return m.CertificateImportFunc(name, filename, passphrase)
+}
+
+// SetCertificateName calls SetCertificateNameFunc.
+func (m *RouterOSMock) SetCertificateName(id string, name string) error {
+	m.record("SetCertificateName", id, name)
+	if m.SetCertificateNameFunc == nil {
+		panic("RouterOSMock.SetCertificateNameFunc: method is nil but SetCertificateName was just called")
+	}
+	return m.SetCertificateNameFunc(id, name)
+}
+
+// RemoveCertificate calls RemoveCertificateFunc.
+func (m *RouterOSMock) RemoveCertificate(id string) error {
+	m.record("RemoveCertificate", id)
+	if m.RemoveCertificateFunc == nil {
+		panic("RouterOSMock.RemoveCertificateFunc: method is nil but RemoveCertificate was just called")
+	}
+	return m.RemoveCertificateFunc(id)
+}
+
+// GetNTPClient calls GetNTPClientFunc.
+func (m *RouterOSMock) GetNTPClient() (any, error) {
+	m.record("GetNTPClient")
+	if m.GetNTPClientFunc == nil {
+		panic("RouterOSMock.GetNTPClientFunc: method is nil but GetNTPClient was just called")
+	}
+	return m.GetNTPClientFunc()
+}
+
+// SetNTPClient calls SetNTPClientFunc.
+func (m *RouterOSMock) SetNTPClient(ntp any) error {
+	m.record("SetNTPClient", ntp)
+	if m.SetNTPClientFunc == nil {
+		panic("RouterOSMock.SetNTPClientFunc: method is nil but SetNTPClient was just called")
+	}
+	return m.SetNTPClientFunc(ntp)
+}
+
+// GetSNMP calls GetSNMPFunc.
+func (m *RouterOSMock) GetSNMP() (gotik.SNMP, error) {
+	m.record("GetSNMP")
+	if m.GetSNMPFunc == nil {
+		panic("RouterOSMock.GetSNMPFunc: method is nil but GetSNMP was just called")
+	}
+	return m.GetSNMPFunc()
+}
+
+// SetSNMP calls SetSNMPFunc.
+func (m *RouterOSMock) SetSNMP(s gotik.SNMP) error {
+	m.record("SetSNMP", s)
+	if m.SetSNMPFunc == nil {
+		panic("RouterOSMock.SetSNMPFunc: method is nil but SetSNMP was just called")
+	}
+	return m.SetSNMPFunc(s)
+}
+
+// GetSNMPCommunities calls GetSNMPCommunitiesFunc.
+func (m *RouterOSMock) GetSNMPCommunities() ([]gotik.SNMPCommunity, error) {
+	m.record("GetSNMPCommunities")
+	if m.GetSNMPCommunitiesFunc == nil {
+		panic("RouterOSMock.GetSNMPCommunitiesFunc: method is nil but GetSNMPCommunities was just called")
+	}
+	return m.GetSNMPCommunitiesFunc()
+}
+
+// AddSNMPCommunity calls AddSNMPCommunityFunc.
+func (m *RouterOSMock) AddSNMPCommunity(community gotik.SNMPCommunity) (string, error) {
+	m.record("AddSNMPCommunity", community)
+	if m.AddSNMPCommunityFunc == nil {
+		panic("RouterOSMock.AddSNMPCommunityFunc: method is nil but AddSNMPCommunity was just called")
+	}
+	return m.AddSNMPCommunityFunc(community)
+}
+
+// UpdateSNMPCommunity calls UpdateSNMPCommunityFunc.
+func (m *RouterOSMock) UpdateSNMPCommunity(community gotik.SNMPCommunity) (string, error) {
+	m.record("UpdateSNMPCommunity", community)
+	if m.UpdateSNMPCommunityFunc == nil {
+		panic("RouterOSMock.UpdateSNMPCommunityFunc: method is nil but UpdateSNMPCommunity was just called")
+	}
+	return m.UpdateSNMPCommunityFunc(community)
+}
+
+// RemoveSNMPCommunity calls RemoveSNMPCommunityFunc.
+func (m *RouterOSMock) RemoveSNMPCommunity(id string) error {
+	m.record("RemoveSNMPCommunity", id)
+	if m.RemoveSNMPCommunityFunc == nil {
+		panic("RouterOSMock.RemoveSNMPCommunityFunc: method is nil but RemoveSNMPCommunity was just called")
+	}
+	return m.RemoveSNMPCommunityFunc(id)
+}
+
+// GetAAA calls GetAAAFunc.
+func (m *RouterOSMock) GetAAA() (gotik.AAA, error) {
+	m.record("GetAAA")
+	if m.GetAAAFunc == nil {
+		panic("RouterOSMock.GetAAAFunc: method is nil but GetAAA was just called")
+	}
+	return m.GetAAAFunc()
+}
+
+// SetAAA calls SetAAAFunc.
+func (m *RouterOSMock) SetAAA(a gotik.AAA) (string, error) {
+	m.record("SetAAA", a)
+	if m.SetAAAFunc == nil {
+		panic("RouterOSMock.SetAAAFunc: method is nil but SetAAA was just called")
+	}
+	return m.SetAAAFunc(a)
+}
+
+// GetRadius calls GetRadiusFunc.
+func (m *RouterOSMock) GetRadius() ([]gotik.RadiusServer, error) {
+	m.record("GetRadius")
+	if m.GetRadiusFunc == nil {
+		panic("RouterOSMock.GetRadiusFunc: method is nil but GetRadius was just called")
+	}
+	return m.GetRadiusFunc()
+}
+
+// AddRadius calls AddRadiusFunc.
+func (m *RouterOSMock) AddRadius(r gotik.RadiusServer, placeBefore string) (string, error) {
+	m.record("AddRadius", r, placeBefore)
+	if m.AddRadiusFunc == nil {
+		panic("RouterOSMock.AddRadiusFunc: method is nil but AddRadius was just called")
+	}
+	return m.AddRadiusFunc(r, placeBefore)
+}
+
+// RemoveRadius calls RemoveRadiusFunc.
+func (m *RouterOSMock) RemoveRadius(id string) error {
+	m.record("RemoveRadius", id)
+	if m.RemoveRadiusFunc == nil {
+		panic("RouterOSMock.RemoveRadiusFunc: method is nil but RemoveRadius was just called")
+	}
+	return m.RemoveRadiusFunc(id)
+}
@@ -0,0 +1,26 @@
+package gotikmock_test
+
+import (
+	"testing"
+
+	"github.com/jjcinaz/gotik"
+	"github.com/jjcinaz/gotik/gotikmock"
+)
+
+// renameRouter is an example of provisioning logic written against a gotik interface.
+func renameRouter(api gotik.InterfaceAPI, id, name string) error {
+	return api.SetInterfaceName(id, name)
+}
+
+func TestRouterOSMock(t *testing.T) {
+	m := &gotikmock.RouterOSMock{
+		SetInterfaceNameFunc: func(id string, newName string) error { return nil },
+	}
+	if err := renameRouter(m, "*1", "wan"); err != nil {
+		t.Fatal(err)
+	}
+	calls := m.Calls()
+	if len(calls) != 1 || calls[0].Method != "SetInterfaceName" || calls[0].Args[1] != "wan" {
+		t.Fatalf("Calls()=%v; want one SetInterfaceName call", calls)
+	}
+}
@@ -0,0 +1,95 @@
+package gotik
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ImportOptions are the options of ImportScript.
+type ImportOptions struct {
+	Verbose bool // report each command as it is run
+	DryRun  bool // check the script without applying it, requires RouterOS 7
+}
+
+// ImportResult holds the messages returned by /import.
+type ImportResult struct {
+	Output []string
+}
+
+var (
+	importLineRegx = regexp.MustCompile(`\(?line (\d+)(?: column (\d+))?\)?`)
+	// importErrorRegx matches the lines of output which report a failure, as opposed to the
+	// commands echoed by a verbose import, which may well contain words such as invalid
+	importErrorRegx = regexp.MustCompile(`(?im)^\s*(failure:|expected |syntax error|bad command name|script error)`)
+)
+
+// ImportScript runs /import on the .rsc file called name on the router.  If the device reports
+// an error, it is returned as an *ImportError holding the failing line and the message.
+func (c *Client) ImportScript(name string, opts ImportOptions) (ImportResult, error) {
+	var result ImportResult
+	a := []string{"/import", "=file-name=" + name}
+	if opts.Verbose {
+		a = append(a, "=verbose=yes")
+	}
+	if opts.DryRun {
+		if c.majorVersion < 7 {
+			return result, ErrVersionTooOld
+		}
+		a = append(a, "=dry-run=yes")
+	}
+	reply, err := c.Run(a...)
+	if err != nil {
+		if derr, ok := err.(*DeviceError); ok {
+			return result, parseImportError(name, derr.Sentence.Map["message"])
+		}
+		return result, err
+	}
+	for _, re := range reply.Re {
+		for _, key := range []string{"message", "ret"} {
+			if m := re.Map[key]; len(m) > 0 {
+				result.Output = append(result.Output, m)
+			}
+		}
+	}
+	if reply.Done != nil && len(reply.Done.Map["ret"]) > 0 {
+		result.Output = append(result.Output, reply.Done.Map["ret"])
+	}
+	// errors in the script are sometimes reported as output rather than as a trap
+	for _, m := range result.Output {
+		if importErrorRegx.MatchString(m) && !strings.Contains(m, "successfully") {
+			return result, parseImportError(name, m)
+		}
+	}
+	return result, nil
+}
+
+// ImportString uploads script to a temporary file on the router, imports it with
+// ImportScript and removes the file.
+func (c *Client) ImportString(script string, opts ImportOptions) (ImportResult, error) {
+	name := fmt.Sprintf("gotik-import-%d.rsc", time.Now().UnixNano())
+	if err := c.UploadFile(name, strings.NewReader(script)); err != nil {
+		return ImportResult{}, err
+	}
+	result, err := c.ImportScript(name, opts)
+	f, ferr := c.findFile(name)
+	if ferr == nil {
+		ferr = c.RemoveFileByID(f.ID)
+	}
+	if err == nil {
+		err = ferr
+	}
+	return result, err
+}
+
+func parseImportError(file, message string) *ImportError {
+	e := &ImportError{File: file, Message: message}
+	if m := importLineRegx.FindStringSubmatch(message); m != nil {
+		e.Line, _ = strconv.Atoi(m[1])
+		e.Column, _ = strconv.Atoi(m[2])
+		e.Message = strings.TrimSpace(strings.Replace(message, m[0], "", 1))
+	}
+	return e
+}
@@ -0,0 +1,74 @@
+package gotik_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jjcinaz/gotik"
+)
+
+func TestImportScript(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+
+	go func() {
+		defer s.Close()
+		s.readSentence(t, "/import @ [{`file-name` `ok.rsc`} {`verbose` `yes`}]")
+		s.writeSentence(t, "!done", "=ret=Script file loaded and executed successfully")
+		s.readSentence(t, "/import @ [{`file-name` `bad.rsc`}]")
+		s.writeSentence(t, "!trap", "=message=expected end of command (line 3 column 12)")
+		s.writeSentence(t, "!done")
+	}()
+
+	result, err := c.ImportScript("ok.rsc", gotik.ImportOptions{Verbose: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Output) != 1 {
+		t.Fatalf("Output=%q; want the success message", result.Output)
+	}
+
+	_, err = c.ImportScript("bad.rsc", gotik.ImportOptions{})
+	var ierr *gotik.ImportError
+	if !errors.As(err, &ierr) {
+		t.Fatalf("ImportScript()=%v; want *ImportError", err)
+	}
+	if ierr.Line != 3 || ierr.Column != 12 || ierr.Message != "expected end of command" {
+		t.Fatalf("ImportError=%+v; want line 3 column 12", ierr)
+	}
+
+	if _, err = c.ImportScript("ok.rsc", gotik.ImportOptions{DryRun: true}); !errors.Is(err, gotik.ErrVersionTooOld) {
+		t.Fatalf("ImportScript() with dry-run on an old version=%v; want %v", err, gotik.ErrVersionTooOld)
+	}
+}
+
+func TestImportScriptVerboseOutput(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+
+	go func() {
+		defer s.Close()
+		s.readSentence(t, "/import @ [{`file-name` `fw.rsc`} {`verbose` `yes`}]")
+		s.writeSentence(t, "!re", "=message=/ip firewall filter add chain=input connection-state=invalid action=drop")
+		s.writeSentence(t, "!re", "=message=/ip firewall filter add chain=input comment=\"no syntax errors expected\"")
+		s.writeSentence(t, "!done", "=ret=Script file loaded and executed successfully")
+		s.readSentence(t, "/import @ [{`file-name` `fw.rsc`} {`verbose` `yes`}]")
+		s.writeSentence(t, "!re", "=message=/ip firewall filter add chain=input connection-state=invalid action=drop")
+		s.writeSentence(t, "!re", "=message=failure: already have such rule")
+		s.writeSentence(t, "!done")
+	}()
+
+	result, err := c.ImportScript("fw.rsc", gotik.ImportOptions{Verbose: true})
+	if err != nil {
+		t.Fatalf("ImportScript()=%v; want the echoed commands to pass", err)
+	}
+	if len(result.Output) != 3 {
+		t.Fatalf("Output=%q; want 3 lines", result.Output)
+	}
+
+	_, err = c.ImportScript("fw.rsc", gotik.ImportOptions{Verbose: true})
+	var ierr *gotik.ImportError
+	if !errors.As(err, &ierr) || ierr.Message != "failure: already have such rule" {
+		t.Fatalf("ImportScript()=%v; want the failure", err)
+	}
+}
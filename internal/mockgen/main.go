@@ -0,0 +1,308 @@
+// Command mockgen generates function-field mocks for the interfaces declared in a single
+// Go source file.  It is used by go generate to build the gotikmock package from api.go
+// and only depends on the standard library.
+//
+// For each interface Foo a FooMock struct is generated with a FooFunc field per method.
+// Calling a method whose field is nil panics.  Every call is recorded and can be read back
+// with Calls().
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var builtins = map[string]bool{
+	"any": true, "bool": true, "byte": true, "complex64": true, "complex128": true, "error": true,
+	"float32": true, "float64": true, "int": true, "int8": true, "int16": true, "int32": true,
+	"int64": true, "rune": true, "string": true, "uint": true, "uint8": true, "uint16": true,
+	"uint32": true, "uint64": true, "uintptr": true,
+}
+
+type param struct {
+	name     string
+	typ      string
+	variadic bool
+}
+
+type method struct {
+	name    string
+	params  []param
+	results []string
+}
+
+type generator struct {
+	srcPkg     string            // name of the package being mocked, e.g. gotik
+	fileImport map[string]string // import name -> path, from the source file
+	used       map[string]string // import name -> path, needed by the output
+	ifaces     map[string]*ast.InterfaceType
+}
+
+func main() {
+	var (
+		in     = flag.String("in", "api.go", "source file declaring the interfaces")
+		out    = flag.String("out", "", "output file (default stdout)")
+		pkg    = flag.String("pkg", "mock", "package name of the generated file")
+		imPath = flag.String("import", "github.com/jjcinaz/gotik", "import path of the source package")
+	)
+	flag.Parse()
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, *in, nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	g := &generator{
+		srcPkg:     f.Name.Name,
+		fileImport: make(map[string]string),
+		used:       map[string]string{"sync": "sync", f.Name.Name: *imPath},
+		ifaces:     make(map[string]*ast.InterfaceType),
+	}
+	for _, im := range f.Imports {
+		path, _ := strconv.Unquote(im.Path.Value)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if im.Name != nil {
+			name = im.Name.Name
+		}
+		g.fileImport[name] = path
+	}
+	var names []string
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts := spec.(*ast.TypeSpec)
+			if it, ok := ts.Type.(*ast.InterfaceType); ok && ts.Name.IsExported() {
+				g.ifaces[ts.Name.Name] = it
+				names = append(names, ts.Name.Name)
+			}
+		}
+	}
+
+	body := &bytes.Buffer{}
+	for _, name := range names {
+		methods, err := g.methods(name)
+		if err != nil {
+			log.Fatal(err)
+		}
+		g.writeMock(body, name, methods)
+	}
+
+	src := &bytes.Buffer{}
+	fmt.Fprintf(src, "// Code generated by internal/mockgen from %s; DO NOT EDIT.\n\n", *in)
+	fmt.Fprintf(src, "package %s\n\nimport (\n", *pkg)
+	// standard library imports first, then the rest, as goimports would group them
+	var std, other []string
+	for name, path := range g.used {
+		im := strconv.Quote(path)
+		if path[strings.LastIndex(path, "/")+1:] != name {
+			im = name + " " + im
+		}
+		if strings.Contains(strings.SplitN(path, "/", 2)[0], ".") {
+			other = append(other, im)
+		} else {
+			std = append(std, im)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(other)
+	for _, im := range std {
+		fmt.Fprintf(src, "\t%s\n", im)
+	}
+	if len(std) > 0 && len(other) > 0 {
+		fmt.Fprintf(src, "\n")
+	}
+	for _, im := range other {
+		fmt.Fprintf(src, "\t%s\n", im)
+	}
+	fmt.Fprintf(src, ")\n\n")
+	for _, name := range names {
+		fmt.Fprintf(src, "var _ %s.%s = (*%sMock)(nil)\n", g.srcPkg, name, name)
+	}
+	src.Write(body.Bytes())
+
+	formatted, err := format.Source(src.Bytes())
+	if err != nil {
+		log.Fatalf("formatting output: %v\n%s", err, src.Bytes())
+	}
+	if *out == "" {
+		_, _ = os.Stdout.Write(formatted)
+		return
+	}
+	if err = os.WriteFile(*out, formatted, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// methods returns the methods of the named interface, including those of embedded interfaces, in declaration order.
+func (g *generator) methods(name string) ([]method, error) {
+	it, ok := g.ifaces[name]
+	if !ok {
+		return nil, fmt.Errorf("embedded interface %s is not declared in the source file", name)
+	}
+	var list []method
+	for _, field := range it.Methods.List {
+		switch t := field.Type.(type) {
+		case *ast.FuncType:
+			list = append(list, g.funcMethod(field.Names[0].Name, t))
+		case *ast.Ident:
+			embedded, err := g.methods(t.Name)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, embedded...)
+		default:
+			return nil, fmt.Errorf("%s: unsupported interface element", name)
+		}
+	}
+	return list, nil
+}
+
+// funcMethod describes a function type, naming any unnamed parameters.
+func (g *generator) funcMethod(name string, t *ast.FuncType) method {
+	m := method{name: name}
+	argN := 0
+	for _, p := range t.Params.List {
+		typ, variadic := "", false
+		if e, ok := p.Type.(*ast.Ellipsis); ok {
+			typ, variadic = g.typeString(e.Elt), true
+		} else {
+			typ = g.typeString(p.Type)
+		}
+		if len(p.Names) == 0 {
+			m.params = append(m.params, param{name: fmt.Sprintf("arg%d", argN), typ: typ, variadic: variadic})
+			argN++
+		}
+		for _, n := range p.Names {
+			m.params = append(m.params, param{name: n.Name, typ: typ, variadic: variadic})
+			argN++
+		}
+	}
+	if t.Results != nil {
+		for _, r := range t.Results.List {
+			n := len(r.Names)
+			if n == 0 {
+				n = 1
+			}
+			for i := 0; i < n; i++ {
+				m.results = append(m.results, g.typeString(r.Type))
+			}
+		}
+	}
+	return m
+}
+
+// typeString renders a type expression, qualifying identifiers declared in the source package.
+func (g *generator) typeString(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if builtins[t.Name] {
+			return t.Name
+		}
+		return g.srcPkg + "." + t.Name
+	case *ast.SelectorExpr:
+		pkg := t.X.(*ast.Ident).Name
+		g.used[pkg] = g.fileImport[pkg]
+		return pkg + "." + t.Sel.Name
+	case *ast.StarExpr:
+		return "*" + g.typeString(t.X)
+	case *ast.ArrayType:
+		if t.Len == nil {
+			return "[]" + g.typeString(t.Elt)
+		}
+		return "[" + t.Len.(*ast.BasicLit).Value + "]" + g.typeString(t.Elt)
+	case *ast.MapType:
+		return "map[" + g.typeString(t.Key) + "]" + g.typeString(t.Value)
+	case *ast.ChanType:
+		switch t.Dir {
+		case ast.RECV:
+			return "<-chan " + g.typeString(t.Value)
+		case ast.SEND:
+			return "chan<- " + g.typeString(t.Value)
+		}
+		return "chan " + g.typeString(t.Value)
+	case *ast.InterfaceType:
+		if len(t.Methods.List) == 0 {
+			return "interface{}"
+		}
+	case *ast.Ellipsis:
+		return "..." + g.typeString(t.Elt)
+	case *ast.FuncType:
+		return "func" + signature(g.funcMethod("", t))
+	}
+	log.Fatalf("unsupported type expression %T", expr)
+	return ""
+}
+
+func (g *generator) writeMock(w *bytes.Buffer, iface string, methods []method) {
+	mock := iface + "Mock"
+	fmt.Fprintf(w, "\n// %s is a mock implementation of %s.%s.\n", mock, g.srcPkg, iface)
+	fmt.Fprintf(w, "type %s struct {\n", mock)
+	for _, m := range methods {
+		fmt.Fprintf(w, "\t// %sFunc mocks the %s method.\n", m.name, m.name)
+		fmt.Fprintf(w, "\t%sFunc func%s\n\n", m.name, signature(m))
+	}
+	fmt.Fprintf(w, "\tmu    sync.Mutex\n\tcalls []Call\n}\n")
+
+	fmt.Fprintf(w, "\n// Calls returns the calls made on the mock so far, in order.\n")
+	fmt.Fprintf(w, "func (m *%s) Calls() []Call {\n\tm.mu.Lock()\n\tdefer m.mu.Unlock()\n", mock)
+	fmt.Fprintf(w, "\treturn append([]Call(nil), m.calls...)\n}\n")
+	fmt.Fprintf(w, "\nfunc (m *%s) record(method string, args ...interface{}) {\n", mock)
+	fmt.Fprintf(w, "\tm.mu.Lock()\n\tm.calls = append(m.calls, Call{Method: method, Args: args})\n\tm.mu.Unlock()\n}\n")
+
+	for _, m := range methods {
+		names := make([]string, 0, len(m.params))
+		args := make([]string, 0, len(m.params))
+		for _, p := range m.params {
+			names = append(names, p.name)
+			if p.variadic {
+				args = append(args, p.name+"...")
+			} else {
+				args = append(args, p.name)
+			}
+		}
+		fmt.Fprintf(w, "\n// %s calls %sFunc.\n", m.name, m.name)
+		fmt.Fprintf(w, "func (m *%s) %s%s {\n", mock, m.name, signature(m))
+		fmt.Fprintf(w, "\tm.record(%s)\n", strings.Join(append([]string{strconv.Quote(m.name)}, names...), ", "))
+		fmt.Fprintf(w, "\tif m.%sFunc == nil {\n", m.name)
+		fmt.Fprintf(w, "\t\tpanic(\"%s.%sFunc: method is nil but %s was just called\")\n\t}\n", mock, m.name, m.name)
+		call := fmt.Sprintf("m.%sFunc(%s)", m.name, strings.Join(args, ", "))
+		if len(m.results) > 0 {
+			fmt.Fprintf(w, "\treturn %s\n}\n", call)
+		} else {
+			fmt.Fprintf(w, "\t%s\n}\n", call)
+		}
+	}
+}
+
+func signature(m method) string {
+	params := make([]string, 0, len(m.params))
+	for _, p := range m.params {
+		if p.variadic {
+			params = append(params, p.name+" ..."+p.typ)
+		} else {
+			params = append(params, p.name+" "+p.typ)
+		}
+	}
+	s := "(" + strings.Join(params, ", ") + ")"
+	switch len(m.results) {
+	case 0:
+	case 1:
+		s += " " + m.results[0]
+	default:
+		s += " (" + strings.Join(m.results, ", ") + ")"
+	}
+	return s
+}
@@ -0,0 +1,37 @@
+// Package order holds the helpers shared by the packages which work out how a list of items
+// was reordered, such as the config diff in rsc and ApplyFilterChain.
+package order
+
+import "sort"
+
+// LongestIncreasing marks the entries of seq, ignoring negative ones, which belong to a longest
+// strictly increasing subsequence.  When seq holds the old position of each item of a list,
+// the marked items kept their order and the others are the ones which moved.
+func LongestIncreasing(seq []int) []bool {
+	var (
+		tails []int // index into seq of the smallest tail of each length
+		prev  = make([]int, len(seq))
+	)
+	for i, v := range seq {
+		prev[i] = -1
+		if v < 0 {
+			continue
+		}
+		n := sort.Search(len(tails), func(k int) bool { return seq[tails[k]] >= v })
+		if n > 0 {
+			prev[i] = tails[n-1]
+		}
+		if n == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[n] = i
+		}
+	}
+	in := make([]bool, len(seq))
+	if len(tails) > 0 {
+		for i := tails[len(tails)-1]; i >= 0; i = prev[i] {
+			in[i] = true
+		}
+	}
+	return in
+}
@@ -0,0 +1,13 @@
+package order
+
+import "testing"
+
+func TestLongestIncreasing(t *testing.T) {
+	in := LongestIncreasing([]int{0, 3, -1, 1, 2})
+	want := []bool{true, false, false, true, true}
+	for i := range want {
+		if in[i] != want[i] {
+			t.Fatalf("LongestIncreasing()=%v; want %v", in, want)
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package gotik
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ruleActionProps are the properties shown by ruleString after each action.
+var ruleActionProps = map[string][]string{
+	"jump":                    {"jump-target"},
+	"add-dst-to-address-list": {"address-list", "address-list-timeout"},
+	"add-src-to-address-list": {"address-list", "address-list-timeout"},
+	"reject":                  {"reject-with"},
+	"mark-connection":         {"new-connection-mark"},
+	"mark-packet":             {"new-packet-mark"},
+	"mark-routing":            {"new-routing-mark"},
+	"change-mss":              {"new-mss"},
+	"change-dscp":             {"new-dscp"},
+	"change-hop-limit":        {"new-hop-limit"},
+	"change-ttl":              {"new-ttl"},
+	"set-priority":            {"new-priority"},
+	"route":                   {"route-dst"},
+	"src-nat":                 {"to-address", "to-ports"},
+	"dst-nat":                 {"to-address", "to-ports"},
+	"netmap":                  {"to-address", "to-ports"},
+	"redirect":                {"to-ports"},
+	"masquerade":              {"to-ports"},
+}
+
+// ruleMatchProps are the matchers shown by ruleString, in order.
+var ruleMatchProps = []string{
+	"protocol", "src-address-list", "src-address", "src-port", "in-interface", "out-interface",
+	"dst-address-list", "dst-address", "dst-port", "icmp-options", "headers", "connection-mark", "packet-mark",
+}
+
+// ruleString renders any firewall rule struct in the style of IPv4FilterRule.String().
+func ruleString(rule interface{}) string {
+	v := reflect.ValueOf(rule).Elem()
+	t := v.Type()
+	props := make(map[string]string, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("tik")
+		if len(tag) == 0 || strings.HasPrefix(tag, "/") {
+			continue
+		}
+		if value, managed := formatTikValue(v.Field(i)); managed {
+			props[tag] = value
+		}
+	}
+	f := []rune{' ', ' ', ' '}
+	if props["disabled"] == "true" {
+		f[0] = 'X'
+	}
+	if props["invalid"] == "true" {
+		f[1] = 'I'
+	}
+	if props["dynamic"] == "true" {
+		f[2] = 'D'
+	}
+	a := make([]string, 0, 24)
+	a = append(a, string(f), fmt.Sprintf("chain=%s", props["chain"]))
+	for _, k := range ruleMatchProps {
+		if len(props[k]) > 0 {
+			a = append(a, fmt.Sprintf("%s=%s", k, props[k]))
+		}
+	}
+	a = append(a, fmt.Sprintf("action=%s", props["action"]))
+	for _, k := range ruleActionProps[props["action"]] {
+		if len(props[k]) > 0 {
+			a = append(a, fmt.Sprintf("%s=%s", k, props[k]))
+		}
+	}
+	if len(props["passthrough"]) > 0 {
+		a = append(a, fmt.Sprintf("passthrough=%s", props["passthrough"]))
+	}
+	if len(props["comment"]) > 0 {
+		a = append(a, fmt.Sprintf("comment=%s", props["comment"]))
+	}
+	return strings.Join(a, " ")
+}
+
+// getRules prints the rules of a firewall table, optionally limited to one chain.
+func getRules[T any](c *Client, location string, chain string) ([]T, error) {
+	a := []string{location + "/print"}
+	if len(chain) > 0 {
+		a = append(a, "?=chain="+chain)
+	}
+	detail, err := c.Run(a...)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]T, 0, 64)
+	for _, re := range detail.Re {
+		var entry T
+		parseTikObject(re.Map, &entry)
+		parseRuleCounters(re.Map, &entry)
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// addRule adds a firewall rule, placed before PlaceBefore if it is set, and returns its ID.
+func addRule[T any](c *Client, location string, chain string, rule *T) (string, error) {
+	if len(chain) == 0 {
+		return "", ErrMissingChain
+	}
+	reply, err := c.Run(GenerateTikSentence(location+"/add", "=", true, rule)...)
+	if err != nil {
+		return "", err
+	}
+	return reply.Done.Map["ret"], nil
+}
+
+// ruleCommand runs a command such as remove, enable or disable on the rule with the given ID.
+func (c *Client) ruleCommand(location, verb, id string) error {
+	if len(id) == 0 {
+		return ErrMissingId
+	}
+	_, err := c.Run(location+"/"+verb, "=.id="+id)
+	return err
+}
+
+func (c *Client) GetIPv6Filters(chain string) ([]IPv6FilterRule, error) {
+	entries, err := getRules[IPv6FilterRule](c, "/ipv6/firewall/filter", chain)
+	for i := range entries {
+		// as with IPv4, some versions return "action=accept" as an empty action
+		if len(entries[i].Action) == 0 {
+			entries[i].Action = "accept"
+		}
+	}
+	return entries, err
+}
+
+func (c *Client) AddIPv6FilterRule(rule IPv6FilterRule) (string, error) {
+	return addRule(c, "/ipv6/firewall/filter", rule.Chain, &rule)
+}
+
+func (c *Client) RemoveIPv6FilterRule(id string) error {
+	return c.ruleCommand("/ipv6/firewall/filter", "remove", id)
+}
+
+func (c *Client) EnableIPv6FilterRule(id string) error {
+	return c.ruleCommand("/ipv6/firewall/filter", "enable", id)
+}
+
+func (c *Client) DisableIPv6FilterRule(id string) error {
+	return c.ruleCommand("/ipv6/firewall/filter", "disable", id)
+}
+
+func (r *IPv6FilterRule) String() string {
+	return ruleString(r)
+}
+
+// IPv6RuleCompareEq is the IPv6 counterpart of IPv4RuleCompareEq.
+func IPv6RuleCompareEq(a, b IPv6FilterRule, propsToIgnore []string) bool {
+	return RuleCompareEq(a, b, propsToIgnore)
+}
+
+// GetIPv6Nat returns the IPv6 NAT rules, which exist in RouterOS 7 only.
+func (c *Client) GetIPv6Nat(chain string) ([]IPv6NatRule, error) {
+	if c.majorVersion > 0 && c.majorVersion < 7 {
+		return nil, ErrVersionTooOld
+	}
+	return getRules[IPv6NatRule](c, "/ipv6/firewall/nat", chain)
+}
+
+func (c *Client) AddIPv6NatRule(rule IPv6NatRule) (string, error) {
+	return addRule(c, "/ipv6/firewall/nat", rule.Chain, &rule)
+}
+
+func (c *Client) RemoveIPv6NatRule(id string) error {
+	return c.ruleCommand("/ipv6/firewall/nat", "remove", id)
+}
+
+func (c *Client) EnableIPv6NatRule(id string) error {
+	return c.ruleCommand("/ipv6/firewall/nat", "enable", id)
+}
+
+func (c *Client) DisableIPv6NatRule(id string) error {
+	return c.ruleCommand("/ipv6/firewall/nat", "disable", id)
+}
+
+func (r *IPv6NatRule) String() string {
+	return ruleString(r)
+}
+
+func (c *Client) GetIPv6Mangle(chain string) ([]IPv6MangleRule, error) {
+	return getRules[IPv6MangleRule](c, "/ipv6/firewall/mangle", chain)
+}
+
+func (c *Client) AddIPv6MangleRule(rule IPv6MangleRule) (string, error) {
+	return addRule(c, "/ipv6/firewall/mangle", rule.Chain, &rule)
+}
+
+func (c *Client) RemoveIPv6MangleRule(id string) error {
+	return c.ruleCommand("/ipv6/firewall/mangle", "remove", id)
+}
+
+func (c *Client) EnableIPv6MangleRule(id string) error {
+	return c.ruleCommand("/ipv6/firewall/mangle", "enable", id)
+}
+
+func (c *Client) DisableIPv6MangleRule(id string) error {
+	return c.ruleCommand("/ipv6/firewall/mangle", "disable", id)
+}
+
+func (r *IPv6MangleRule) String() string {
+	return ruleString(r)
+}
+
+func (c *Client) GetIPv6Raw(chain string) ([]IPv6RawRule, error) {
+	return getRules[IPv6RawRule](c, "/ipv6/firewall/raw", chain)
+}
+
+func (c *Client) AddIPv6RawRule(rule IPv6RawRule) (string, error) {
+	return addRule(c, "/ipv6/firewall/raw", rule.Chain, &rule)
+}
+
+func (c *Client) RemoveIPv6RawRule(id string) error {
+	return c.ruleCommand("/ipv6/firewall/raw", "remove", id)
+}
+
+func (c *Client) EnableIPv6RawRule(id string) error {
+	return c.ruleCommand("/ipv6/firewall/raw", "enable", id)
+}
+
+func (c *Client) DisableIPv6RawRule(id string) error {
+	return c.ruleCommand("/ipv6/firewall/raw", "disable", id)
+}
+
+func (r *IPv6RawRule) String() string {
+	return ruleString(r)
+}
@@ -0,0 +1,54 @@
+package gotik_test
+
+import (
+	"testing"
+
+	"github.com/jjcinaz/gotik"
+)
+
+func TestIPv6FilterRules(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+
+	go func() {
+		defer s.Close()
+		s.readSentence(t, "/ipv6/firewall/filter/add @ [{`action` `drop`} {`chain` `input`} {`protocol` `icmpv6`} {`icmp-options` `128:0`} {`headers` `hop`}]")
+		s.writeSentence(t, "!done", "=ret=*B")
+		s.readSentence(t, "/ipv6/firewall/filter/print @ []")
+		s.writeSentence(t, "!re", "=.id=*B", "=chain=input", "=action=drop", "=protocol=icmpv6", "=icmp-options=128:0", "=headers=hop")
+		s.writeSentence(t, "!done")
+		s.readSentence(t, "/ipv6/firewall/nat/add @ [{`chain` `dstnat`} {`protocol` `tcp`} {`dst-port` `443`} {`to-address` `fd00::10`} {`action` `dst-nat`}]")
+		s.writeSentence(t, "!done", "=ret=*C")
+		s.readSentence(t, "/ipv6/firewall/raw/disable @ [{`.id` `*D`}]")
+		s.writeSentence(t, "!done")
+	}()
+
+	rule := gotik.IPv6FilterRule{Chain: "input", Protocol: "icmpv6", IcmpOptions: "128:0", Headers: "hop", Action: "drop"}
+	if _, err := c.AddIPv6FilterRule(rule); err != nil {
+		t.Fatal(err)
+	}
+	rules, err := c.GetIPv6Filters("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 1 || !gotik.IPv6RuleCompareEq(rules[0], rule, nil) {
+		t.Fatalf("GetIPv6Filters()=%v; want %s", rules, rule.String())
+	}
+	if want := "    chain=input protocol=icmpv6 icmp-options=128:0 headers=hop action=drop"; rules[0].String() != want {
+		t.Errorf("String()=%q; want %q", rules[0].String(), want)
+	}
+
+	nat := gotik.IPv6NatRule{Chain: "dstnat", Protocol: "tcp", DstPort: gotik.Ports(443), Action: "dst-nat", ToAddress: "fd00::10"}
+	if _, err = c.AddIPv6NatRule(nat); err != nil {
+		t.Fatal(err)
+	}
+	if want := "    chain=dstnat protocol=tcp dst-port=443 action=dst-nat to-address=fd00::10"; nat.String() != want {
+		t.Errorf("String()=%q; want %q", nat.String(), want)
+	}
+	if err = c.DisableIPv6RawRule("*D"); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.RemoveIPv6MangleRule(""); err != gotik.ErrMissingId {
+		t.Fatalf("RemoveIPv6MangleRule(\"\")=%v; want %v", err, gotik.ErrMissingId)
+	}
+}
@@ -0,0 +1,211 @@
+package gotik
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// JournalEntry is one record of a change journal.  Each entry holds the hash of the entry
+// before it, so editing, removing or reordering entries breaks the chain.
+type JournalEntry struct {
+	Seq      int64     `json:"seq"`
+	Time     time.Time `json:"time"`
+	Router   string    `json:"router"`  // system identity, from GetSystemId
+	Address  string    `json:"address"` // DNS name or IP address the client connected to
+	User     string    `json:"user"`
+	Path     string    `json:"path"` // menu path, for example "/ip/firewall/filter"
+	Verb     string    `json:"verb"` // add, set, remove, reboot...
+	Args     []string  `json:"args"` // remaining words of the command with secrets redacted and contents digested
+	Ret      string    `json:"ret,omitempty"`
+	Result   string    `json:"result"` // "ok" or the error message
+	PrevHash string    `json:"prev_hash"`
+	Hash     string    `json:"hash"`
+}
+
+// Journal is an append-only, tamper-evident record of every command which changes a device.
+// Entries are written as JSON lines, each chained to the previous one by a SHA-256 hash.
+// Attach a Journal to a client with SetJournal and check a journal with VerifyJournal.
+type Journal struct {
+	mu   sync.Mutex
+	w    io.Writer
+	seq  int64
+	prev string
+	err  error
+}
+
+// redactedWords are the parts of argument names whose values are never written to a journal,
+// for example password, authentication-password, ipsec-secret, private-key or tcp-md5-key.
+var redactedWords = []string{"password", "secret", "key", "passphrase", "psk"}
+
+// isRedacted returns true if the value of the argument named key must not be journaled.
+func isRedacted(key string) bool {
+	for _, w := range redactedWords {
+		if strings.Contains(key, w) {
+			return true
+		}
+	}
+	return false
+}
+
+// digestedKeys are the arguments whose values may be large or hold key material, such as the
+// contents of an uploaded file or the source of a script.  Only their length and SHA-256 are
+// written to a journal.
+var digestedKeys = map[string]bool{"contents": true, "source": true}
+
+// NewJournal returns a new Journal which writes to w, starting a new hash chain.
+// To continue an existing journal, use ResumeJournal.
+func NewJournal(w io.Writer) *Journal {
+	return &Journal{w: w}
+}
+
+// ResumeJournal verifies the existing journal read from r and returns a Journal which
+// appends to w, continuing its hash chain.  Typically r and w are the same file opened
+// with os.O_APPEND|os.O_RDWR.
+func ResumeJournal(w io.Writer, r io.Reader) (*Journal, error) {
+	last, err := verifyJournal(r)
+	if err != nil {
+		return nil, err
+	}
+	return &Journal{w: w, seq: last.Seq, prev: last.Hash}, nil
+}
+
+// Err returns the first error which occurred writing to the journal.
+func (j *Journal) Err() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.err
+}
+
+// SetJournal attaches a journal to the client, or detaches it if j is nil.  Every command sent
+// afterward which changes the device (see SetReadOnly for the list) is recorded along with the
+// router identity, the user, the time, the arguments with secrets redacted, the returned ID
+// and the result.  File contents and script sources are recorded as their length and SHA-256.  The router identity is read with GetSystemId when the journal is attached.
+// Commands captured in dry-run mode or rejected by the read-only guard are not recorded since
+// they never reach the device.  Write errors are available from j.Err().
+func (c *Client) SetJournal(j *Journal) error {
+	var (
+		name string
+		err  error
+	)
+	if j != nil {
+		if name, err = c.GetSystemId(); err != nil {
+			return err
+		}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.journal = j
+	c.journalRouter = name
+	return nil
+}
+
+func (j *Journal) record(c *Client, sentence []string, r *Reply, err error) {
+	path, verb := splitCommand(sentence[0])
+	c.mu.Lock()
+	e := JournalEntry{
+		Time:    time.Now().UTC(),
+		Router:  c.journalRouter,
+		Address: c.serverName,
+		User:    c.username,
+		Path:    path,
+		Verb:    verb,
+		Args:    redactArgs(sentence[1:]),
+		Result:  "ok",
+	}
+	c.mu.Unlock()
+	if err != nil {
+		e.Result = err.Error()
+	} else if r != nil && r.Done != nil {
+		e.Ret = r.Done.Map["ret"]
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.err != nil {
+		return
+	}
+	e.Seq = j.seq + 1
+	e.PrevHash = j.prev
+	e.Hash = e.computeHash()
+	b, merr := json.Marshal(&e)
+	if merr != nil {
+		j.err = merr
+		return
+	}
+	if _, werr := j.w.Write(append(b, '\n')); werr != nil {
+		j.err = werr
+		return
+	}
+	j.seq = e.Seq
+	j.prev = e.Hash
+}
+
+// computeHash returns the hash of the entry with its Hash field left empty.
+func (e JournalEntry) computeHash() string {
+	e.Hash = ""
+	b, _ := json.Marshal(&e)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func redactArgs(words []string) []string {
+	args := make([]string, 0, len(words))
+	for _, word := range words {
+		if strings.HasPrefix(word, "=") {
+			kv := strings.SplitN(word[1:], "=", 2)
+			switch {
+			case len(kv) != 2:
+			case isRedacted(kv[0]):
+				word = "=" + kv[0] + "=***"
+			case digestedKeys[kv[0]]:
+				sum := sha256.Sum256([]byte(kv[1]))
+				word = fmt.Sprintf("=%s=%d bytes sha256:%s", kv[0], len(kv[1]), hex.EncodeToString(sum[:]))
+			}
+		}
+		args = append(args, word)
+	}
+	return args
+}
+
+// VerifyJournal reads a journal and checks that every entry is intact and chained to the one
+// before it.  It returns the number of entries verified and, if the chain is broken, an error
+// naming the first line at fault.
+func VerifyJournal(r io.Reader) (int, error) {
+	last, err := verifyJournal(r)
+	return int(last.Seq), err
+}
+
+func verifyJournal(r io.Reader) (JournalEntry, error) {
+	var last JournalEntry
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		var e JournalEntry
+		line++
+		if len(strings.TrimSpace(scanner.Text())) == 0 {
+			continue
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return last, fmt.Errorf("journal line %d: %v", line, err)
+		}
+		if e.Seq != last.Seq+1 {
+			return last, fmt.Errorf("journal line %d: sequence %d follows %d", line, e.Seq, last.Seq)
+		}
+		if e.PrevHash != last.Hash {
+			return last, fmt.Errorf("journal line %d: previous hash does not match", line)
+		}
+		if e.Hash != e.computeHash() {
+			return last, fmt.Errorf("journal line %d: entry has been modified", line)
+		}
+		last = e
+	}
+	return last, scanner.Err()
+}
@@ -0,0 +1,62 @@
+package gotik
+
+import "time"
+
+// DefaultKeepaliveMaxMissed is used when Client.KeepaliveMaxMissed is zero.
+const DefaultKeepaliveMaxMissed = 3
+
+// keepaliveLoop runs alongside the async loop when KeepaliveInterval is set.  At every
+// interval it checks that the previous keepalive was answered and sends another.  If
+// too many intervals pass without a reply, the connection is declared lost and closed,
+// which ends the async loop and fails all outstanding tags with ErrConnectionLost.
+func (c *Client) keepaliveLoop(done <-chan struct{}) {
+	var (
+		ack    chan struct{}
+		missed int
+	)
+	maxMissed := c.KeepaliveMaxMissed
+	if maxMissed <= 0 {
+		maxMissed = DefaultKeepaliveMaxMissed
+	}
+	ticker := time.NewTicker(c.KeepaliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+		if ack != nil {
+			select {
+			case <-ack:
+				missed = 0
+			default:
+				missed++
+				if missed >= maxMissed {
+					c.connectionLost()
+					return
+				}
+				// keep waiting on the outstanding keepalive rather than piling up more
+				continue
+			}
+		}
+		ack = make(chan struct{})
+		// Send from a separate goroutine since a write on a dead connection may block
+		go c.sendKeepalive(ack)
+	}
+}
+
+func (c *Client) sendKeepalive(ack chan<- struct{}) {
+	p := c.RunAsync("/system/identity/print", "=.proplist=name")
+	<-p.Done()
+	close(ack)
+}
+
+// connectionLost records ErrConnectionLost and closes the connection so that the
+// blocked read in the async loop returns.  It must not take c.mu, which a writer
+// blocked on the dead connection may be holding; closing the connection is what
+// unblocks that writer.
+func (c *Client) connectionLost() {
+	c.lost.Store(true)
+	_ = c.rwc.Close()
+}
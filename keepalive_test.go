@@ -0,0 +1,64 @@
+package gotik_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jjcinaz/gotik"
+)
+
+func TestKeepaliveConnectionLost(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+	defer s.Close()
+
+	go func() {
+		// Swallow everything and never reply, like a dead peer
+		for {
+			if _, err := s.r.ReadSentence(); err != nil {
+				return
+			}
+		}
+	}()
+
+	c.KeepaliveInterval = 10 * time.Millisecond
+	c.KeepaliveMaxMissed = 2
+	errC := c.Async()
+	p := c.RunAsync("/tool/torch", "=interface=ether1")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if _, err := p.Wait(ctx); err != gotik.ErrConnectionLost {
+		t.Fatalf("Wait()=%v; want %v", err, gotik.ErrConnectionLost)
+	}
+	select {
+	case err := <-errC:
+		if err != gotik.ErrConnectionLost {
+			t.Fatalf("Async()=%v; want %v", err, gotik.ErrConnectionLost)
+		}
+	case <-ctx.Done():
+		t.Fatal("no error reported on Async() channel")
+	}
+}
+
+func TestKeepaliveBlockedWrite(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+	defer s.Close()
+
+	// The peer never reads, so the first keepalive write blocks while holding the
+	// client's lock; the keepalive must still be able to declare the connection lost.
+	c.KeepaliveInterval = 10 * time.Millisecond
+	c.KeepaliveMaxMissed = 2
+	errC := c.Async()
+
+	select {
+	case err := <-errC:
+		if err != gotik.ErrConnectionLost {
+			t.Fatalf("Async()=%v; want %v", err, gotik.ErrConnectionLost)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("keepalive did not close a connection with a blocked write")
+	}
+}
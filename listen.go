@@ -11,8 +11,9 @@ import (
 // RouterOS sentence that caused it to be closed.
 type ListenReply struct {
 	chanReply
-	Done *proto.Sentence
-	c    *Client
+	Done     *proto.Sentence
+	c        *Client
+	sentence []string // the command, kept for the journal if it may change the device
 }
 
 // Chan returns a channel for receiving !re RouterOS sentences.
@@ -38,6 +39,12 @@ func (c *Client) ListenArgs(sentence []string) (*ListenReply, error) {
 
 // ListenArgsQueue sends a sentence to the RouterOS device and returns immediately.
 func (c *Client) ListenArgsQueue(sentence []string, queueSize int) (*ListenReply, error) {
+	if r, handled, err := c.intercept(sentence); handled {
+		if err != nil {
+			return nil, err
+		}
+		return handledListen(c, sentence, r), nil
+	}
 	if !c.async {
 		c.Async()
 	}
@@ -45,7 +52,13 @@ func (c *Client) ListenArgsQueue(sentence []string, queueSize int) (*ListenReply
 	c.nextTag++
 	l := &ListenReply{c: c}
 	l.tag = fmt.Sprintf("l%d", c.nextTag)
-	l.reC = make(chan *proto.Sentence, queueSize)
+	if len(sentence) > 0 {
+		l.cmd = sentence[0]
+	}
+	l.init(queueSize)
+	if !isReadCommand(sentence) {
+		l.sentence = sentence
+	}
 
 	c.w.BeginSentence()
 	for _, word := range sentence {
@@ -67,6 +80,30 @@ func (c *Client) ListenArgsQueue(sentence []string, queueSize int) (*ListenReply
 	return l, nil
 }
 
+// handledListen returns a finished ListenReply holding r, the result of a command which
+// intercept handled without sending it, such as one captured in dry-run mode.
+func handledListen(c *Client, sentence []string, r *Reply) *ListenReply {
+	l := &ListenReply{c: c}
+	if len(sentence) > 0 {
+		l.cmd = sentence[0]
+	}
+	l.init(len(r.Re))
+	for _, re := range r.Re {
+		l.reC <- re
+	}
+	l.Done = r.Done
+	l.close(nil)
+	return l
+}
+
+// finish records a command which may have changed the device in the journal once it ends.
+func (l *ListenReply) finish(err error) (bool, error) {
+	if l.sentence != nil {
+		l.c.afterCommand(l.sentence, &Reply{Done: l.Done}, err)
+	}
+	return true, err
+}
+
 func (l *ListenReply) processSentence(sen *proto.Sentence) (bool, error) {
 	switch sen.Word {
 	case "!re":
@@ -75,15 +112,15 @@ func (l *ListenReply) processSentence(sen *proto.Sentence) (bool, error) {
 		// !empty was added with ROS 7.18; just ignore it for async
 	case "!done":
 		l.Done = sen
-		return true, nil
+		return l.finish(nil)
 	case "!trap":
 		if sen.Map["category"] == "2" {
 			l.Done = sen // "execution of command interrupted"
-			return true, nil
+			return l.finish(nil)
 		}
-		return true, &DeviceError{sen}
+		return l.finish(&DeviceError{sen})
 	case "!fatal":
-		return true, &DeviceError{sen}
+		return l.finish(&DeviceError{sen})
 	case "":
 		// API docs say that empty sentences should be ignored
 	default:
@@ -0,0 +1,25 @@
+package gotik
+
+func (c *Client) GetIPv4Mangle(chain string) ([]IPv4MangleRule, error) {
+	return getRules[IPv4MangleRule](c, "/ip/firewall/mangle", chain)
+}
+
+func (c *Client) AddIPv4MangleRule(rule IPv4MangleRule) (string, error) {
+	return addRule(c, "/ip/firewall/mangle", rule.Chain, &rule)
+}
+
+func (c *Client) RemoveIPv4MangleRule(id string) error {
+	return c.ruleCommand("/ip/firewall/mangle", "remove", id)
+}
+
+func (c *Client) EnableIPv4MangleRule(id string) error {
+	return c.ruleCommand("/ip/firewall/mangle", "enable", id)
+}
+
+func (c *Client) DisableIPv4MangleRule(id string) error {
+	return c.ruleCommand("/ip/firewall/mangle", "disable", id)
+}
+
+func (r *IPv4MangleRule) String() string {
+	return ruleString(r)
+}
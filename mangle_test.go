@@ -0,0 +1,56 @@
+package gotik_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jjcinaz/gotik"
+)
+
+func TestMangleAndRawRules(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+
+	go func() {
+		defer s.Close()
+		s.readSentence(t, "/ip/firewall/mangle/add @ [{`action` `mark-connection`} {`new-connection-mark` `voip`} {`passthrough` `no`} {`chain` `prerouting`} {`dst-port` `5060`} {`protocol` `udp`}]")
+		s.writeSentence(t, "!done", "=ret=*A")
+		s.readSentence(t, "/ip/firewall/raw/add @ [{`action` `notrack`} {`chain` `prerouting`} {`src-address` `10.0.0.0/8`}]")
+		s.writeSentence(t, "!done")
+		s.readSentence(t, "/ip/firewall/mangle/print @ []")
+		s.writeSentence(t, "!re", "=.id=*A", "=chain=prerouting", "=action=mark-connection", "=new-connection-mark=voip", "=passthrough=false", "=protocol=udp", "=dst-port=5060")
+		s.writeSentence(t, "!done")
+	}()
+
+	id, err := c.AddIPv4MangleRule(gotik.IPv4MangleRule{
+		Chain:             "prerouting",
+		Protocol:          "udp",
+		DstPort:           gotik.Ports(5060),
+		Action:            "mark-connection",
+		NewConnectionMark: "voip",
+		Passthrough:       "no",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "*A" {
+		t.Fatalf("AddIPv4MangleRule()=%s; want *A", id)
+	}
+	if err = c.AddRule(&gotik.IPv4RawRule{Chain: "prerouting", SrcAddress: "10.0.0.0/8", Action: "notrack"}); err != nil {
+		t.Fatal(err)
+	}
+	rules, err := c.GetIPv4Mangle("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "    chain=prerouting protocol=udp dst-port=5060 action=mark-connection new-connection-mark=voip passthrough=false"
+	if len(rules) != 1 || rules[0].String() != want {
+		t.Fatalf("GetIPv4Mangle()=%v; want %s", rules, want)
+	}
+	raw := gotik.IPv4RawRule{Chain: "prerouting", Disabled: true, SrcAddress: "198.51.100.0/24",
+		Action: "add-src-to-address-list", AddressList: "scanners", AddressListTimeout: time.Hour}
+	want = "X   chain=prerouting src-address=198.51.100.0/24 action=add-src-to-address-list address-list=scanners address-list-timeout=1h0m0s"
+	if raw.String() != want {
+		t.Fatalf("String()=%q; want %q", raw.String(), want)
+	}
+}
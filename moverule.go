@@ -0,0 +1,151 @@
+package gotik
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// MoveRule repositions one or more rules using any struct type which includes a RouterLocation
+// member (IPv4FilterRule, IPv4NatRule, etc.)  Call the function with a pointer to the struct,
+// c.MoveRule(&someRule, "top"), or with a slice of rules (or pointers to rules) to move a block
+// of rules together, in the order given, with a single move command.  A rule whose ID is empty
+// is located by an exact match, as with RemoveRule.
+//
+// before gives the position to move to:
+//
+//	top       --> The top of the chain of the (first) rule.
+//	bottom    --> The bottom of the chain of the (first) rule.
+//	*ID       --> Before the rule with that ID.
+//	"comment" --> Before the rule with the specified comment. There can only be one rule with that comment.
+func (c *Client) MoveRule(in interface{}, before string) error {
+	var (
+		rules    []reflect.Value
+		location string
+		chain    string
+		ids      []string
+	)
+	v := reflect.ValueOf(in)
+	if v.Kind() == reflect.Slice {
+		for i := 0; i < v.Len(); i++ {
+			rules = append(rules, v.Index(i))
+		}
+	} else {
+		rules = append(rules, v)
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+	for i, r := range rules {
+		if r.Kind() != reflect.Ptr {
+			// GetIDS needs a pointer
+			p := reflect.New(r.Type())
+			p.Elem().Set(r)
+			r = p
+		}
+		loc, id, ch := ruleFields(r.Elem())
+		if len(loc) == 0 {
+			return errors.New("no RouterLocation field in structure")
+		}
+		if i == 0 {
+			location, chain = loc, ch
+		} else if loc != location {
+			return errors.New("moveRule - rules are in different locations")
+		}
+		if len(id) == 0 {
+			found, _, err := c.GetIDS(r.Interface())
+			if err != nil {
+				return err
+			}
+			if len(found) != 1 {
+				return fmt.Errorf("moveRule - %d matching rules", len(found))
+			}
+			id = found[0]
+		}
+		ids = append(ids, id)
+	}
+
+	var destination string
+	switch {
+	case before == "top" || before == "bottom":
+		detail, err := c.Run(location+"/print", "=.proplist=.id,chain")
+		if err != nil {
+			return err
+		}
+		moving := make(map[string]bool, len(ids))
+		for _, id := range ids {
+			moving[id] = true
+		}
+		last := -1
+		for i, re := range detail.Re {
+			if moving[re.Map[".id"]] || (len(chain) > 0 && re.Map["chain"] != chain) {
+				continue
+			}
+			if before == "top" {
+				destination = re.Map[".id"]
+				break
+			}
+			last = i
+		}
+		if before == "bottom" && last >= 0 {
+			// before whatever follows the last rule of the chain, or the end of the list
+			for _, re := range detail.Re[last+1:] {
+				if !moving[re.Map[".id"]] {
+					destination = re.Map[".id"]
+					break
+				}
+			}
+		}
+		if before == "top" && len(destination) == 0 {
+			return nil
+		}
+	case isRosId(before):
+		destination = before
+	case len(before) > 0:
+		detail, err := c.Run(location+"/print", "=.proplist=.id", "?comment="+before)
+		if err != nil {
+			return err
+		}
+		switch len(detail.Re) {
+		case 0:
+			return ErrNotFound
+		case 1:
+			destination = detail.Re[0].Map[".id"]
+		default:
+			return errors.New("moveRule - more than one rule returned")
+		}
+	default:
+		return errors.New("moveRule - missing position")
+	}
+	return c.moveIDs(location, ids, destination)
+}
+
+// moveIDs moves the rules with the given IDs, in that order, before the rule destination,
+// or to the end of the list if destination is empty.
+func (c *Client) moveIDs(location string, ids []string, destination string) error {
+	a := []string{location + "/move", "=numbers=" + strings.Join(ids, ",")}
+	if len(destination) > 0 {
+		a = append(a, "=destination="+destination)
+	}
+	_, err := c.Run(a...)
+	return err
+}
+
+// ruleFields returns the RouterLocation tag, ID and chain of a rule struct.
+func ruleFields(s reflect.Value) (location, id, chain string) {
+	t := s.Type()
+	for i := 0; i < s.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("tik")
+		switch {
+		case field.Name == "RouterLocation":
+			location = tag
+		case tag == ".id":
+			id = s.Field(i).String()
+		case tag == "chain":
+			chain = s.Field(i).String()
+		}
+	}
+	return location, id, chain
+}
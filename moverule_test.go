@@ -0,0 +1,55 @@
+package gotik_test
+
+import (
+	"bufio"
+	"testing"
+
+	"github.com/jjcinaz/gotik"
+)
+
+func TestMoveRule(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+	r := bufio.NewReader(s.Closer.(*conn).PipeReader)
+
+	table := func() {
+		s.writeSentence(t, "!re", "=.id=*1", "=chain=input")
+		s.writeSentence(t, "!re", "=.id=*2", "=chain=input")
+		s.writeSentence(t, "!re", "=.id=*3", "=chain=forward")
+		s.writeSentence(t, "!re", "=.id=*4", "=chain=forward")
+		s.writeSentence(t, "!re", "=.id=*5", "=chain=output")
+		s.writeSentence(t, "!done")
+	}
+	go func() {
+		defer s.Close()
+		readWords(t, r, "/ip/firewall/filter/print =.proplist=.id,chain")
+		table()
+		readWords(t, r, "/ip/firewall/filter/move =numbers=*4 =destination=*3")
+		s.writeSentence(t, "!done")
+		readWords(t, r, "/ip/firewall/filter/print =.proplist=.id,chain")
+		table()
+		readWords(t, r, "/ip/firewall/filter/move =numbers=*1,*2 =destination=*5")
+		s.writeSentence(t, "!done")
+		readWords(t, r, "/ip/firewall/nat/print =.proplist=.id ?comment=drop all")
+		s.writeSentence(t, "!re", "=.id=*9")
+		s.writeSentence(t, "!done")
+		readWords(t, r, "/ip/firewall/nat/move =numbers=*7 =destination=*9")
+		s.writeSentence(t, "!done")
+		readWords(t, r, "/ip/firewall/filter/move =numbers=*2 =destination=*1")
+		s.writeSentence(t, "!done")
+	}()
+
+	if err := c.MoveRule(&gotik.IPv4FilterRule{ID: "*4", Chain: "forward"}, "top"); err != nil {
+		t.Fatal(err)
+	}
+	block := []gotik.IPv4FilterRule{{ID: "*1", Chain: "forward"}, {ID: "*2", Chain: "forward"}}
+	if err := c.MoveRule(block, "bottom"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.MoveRule(&gotik.IPv4NatRule{ID: "*7"}, "drop all"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.MoveRule(&gotik.IPv4FilterRule{ID: "*2"}, "*1"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -18,6 +18,7 @@ func (c *Client) GetIPv4Nat(chain string) ([]IPv4NatRule, error) {
 	for _, re := range detail.Re {
 		var entry IPv4NatRule
 		parseTikObject(re.Map, &entry)
+		parseRuleCounters(re.Map, &entry)
 		entries = append(entries, entry)
 	}
 	return entries, nil
@@ -53,8 +54,8 @@ func (r *IPv4NatRule) String() string {
 	if len(r.SrcAddress) > 0 {
 		a = append(a, fmt.Sprintf("src-address=%s", r.SrcAddress))
 	}
-	if r.SrcPort > 0 {
-		a = append(a, fmt.Sprintf("src-port=%d", r.SrcPort))
+	if !r.SrcPort.IsZero() {
+		a = append(a, "src-port="+r.SrcPort.String())
 	}
 	if len(r.InInterface) > 0 {
 		a = append(a, fmt.Sprintf("in-interface=%s", r.InInterface))
@@ -68,8 +69,8 @@ func (r *IPv4NatRule) String() string {
 	if len(r.DstAddress) > 0 {
 		a = append(a, fmt.Sprintf("dst-address=%s", r.DstAddress))
 	}
-	if r.DstPort > 0 {
-		a = append(a, fmt.Sprintf("dst-port=%d", r.DstPort))
+	if !r.DstPort.IsZero() {
+		a = append(a, "dst-port="+r.DstPort.String())
 	}
 	if r.Action == "jump" {
 		a = append(a, "action=jump", fmt.Sprintf("jump-target=%s", r.JumpTarget))
@@ -77,8 +78,8 @@ func (r *IPv4NatRule) String() string {
 		a = append(a, "action=return")
 	} else {
 		a = append(a, fmt.Sprintf("action=%s", r.Action), fmt.Sprintf("to-addresses=%s", r.ToAddresses))
-		if r.ToPorts != 0 {
-			a = append(a, fmt.Sprintf("to-ports=%d", r.ToPorts))
+		if !r.ToPorts.IsZero() {
+			a = append(a, "to-ports="+r.ToPorts.String())
 		}
 	}
 	if len(r.Comment) > 0 {
@@ -86,3 +87,45 @@ func (r *IPv4NatRule) String() string {
 	}
 	return strings.Join(a, " ")
 }
+
+// AddPortForward adds a dstnat rule which sends new connections arriving on inInterface for
+// dstPort to toAddress, on toPorts if it is not empty.  protocol is usually tcp or udp.
+func (c *Client) AddPortForward(inInterface, protocol string, dstPort PortSet, toAddress string, toPorts PortSet, comment string) error {
+	if len(protocol) == 0 {
+		return ErrMissingProtocol
+	}
+	return c.AddRule(&IPv4NatRule{
+		Chain:       "dstnat",
+		Action:      "dst-nat",
+		InInterface: inInterface,
+		Protocol:    protocol,
+		DstPort:     dstPort,
+		ToAddresses: toAddress,
+		ToPorts:     toPorts,
+		Comment:     comment,
+	})
+}
+
+// AddMasquerade adds a srcnat rule which masquerades connections leaving through outInterface.
+func (c *Client) AddMasquerade(outInterface, comment string) error {
+	return c.AddRule(&IPv4NatRule{
+		Chain:        "srcnat",
+		Action:       "masquerade",
+		OutInterface: outInterface,
+		Comment:      comment,
+	})
+}
+
+// AddSrcNAT adds a srcnat rule which changes the source of connections from srcAddress (an
+// address or network) leaving through outInterface to toAddress.  Either of srcAddress or
+// outInterface may be empty.
+func (c *Client) AddSrcNAT(srcAddress, outInterface, toAddress, comment string) error {
+	return c.AddRule(&IPv4NatRule{
+		Chain:        "srcnat",
+		Action:       "src-nat",
+		SrcAddress:   srcAddress,
+		OutInterface: outInterface,
+		ToAddresses:  toAddress,
+		Comment:      comment,
+	})
+}
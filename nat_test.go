@@ -0,0 +1,53 @@
+package gotik_test
+
+import (
+	"testing"
+
+	"github.com/jjcinaz/gotik"
+)
+
+func TestGetIPv4NatPorts(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+
+	go func() {
+		defer s.Close()
+		s.expectCommand(t, "/ip/firewall/nat/print")
+		s.writeSentence(t, "!re", "=.id=*1", "=chain=dstnat", "=action=dst-nat", "=protocol=tcp",
+			"=dst-port=8000-8100,9000", "=to-addresses=192.168.88.10", "=to-ports=80")
+		s.writeSentence(t, "!done")
+	}()
+
+	rules, err := c.GetIPv4Nat("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 1 {
+		t.Fatalf("got %d rules; want 1", len(rules))
+	}
+	if got := rules[0].DstPort.String(); got != "8000-8100,9000" {
+		t.Errorf("dst-port = %q; want 8000-8100,9000", got)
+	}
+	if rules[0].ToPorts.First() != 80 {
+		t.Errorf("to-ports = %s; want 80", rules[0].ToPorts.String())
+	}
+}
+
+func TestAddPortForward(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+
+	go func() {
+		defer s.Close()
+		s.readSentence(t, "/ip/firewall/nat/add @ [{`chain` `dstnat`} {`protocol` `tcp`} {`dst-port` `8000-8100`} {`in-interface` `ether1`} {`to-addresses` `192.168.88.10`} {`action` `dst-nat`}]")
+		s.writeSentence(t, "!done", "=ret=*5")
+	}()
+
+	err := c.AddPortForward("ether1", "tcp", gotik.PortsRange(8000, 8100), "192.168.88.10", gotik.PortSet{}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.AddPortForward("ether1", "", gotik.Ports(80), "192.168.88.10", gotik.PortSet{}, ""); err != gotik.ErrMissingProtocol {
+		t.Fatalf("err = %v; want ErrMissingProtocol", err)
+	}
+}
@@ -73,6 +73,11 @@ func parseInt(s string) int {
 	return int(i)
 }
 
+func parseInt64(s string) int64 {
+	i, _ := strconv.ParseInt(s, 10, 64)
+	return i
+}
+
 func parseHex(s string) int {
 	var i int64
 	if len(s) > 2 && s[0:2] == "0x" {
@@ -175,11 +180,29 @@ func parseTikObject(props map[string]string, i interface{}) {
 				dstObj.Field(i).SetInt(int64(parseInt(input)))
 			case "Duration":
 				dstObj.Field(i).Set(reflect.ValueOf(parseDuration(input)))
+			case "PortSet":
+				ports, _ := ParsePortSet(input)
+				dstObj.Field(i).Set(reflect.ValueOf(ports))
 			case "Time":
 				if dstObj.Field(i).CanAddr() {
 					dstObj.Field(i).Set(reflect.ValueOf(parseTime(input)))
 				}
+			case "":
+				if field.Type.Kind() == reflect.Slice && field.Type.Elem().Kind() == reflect.String && len(input) > 0 {
+					dstObj.Field(i).Set(reflect.ValueOf(strings.Split(input, ",")))
+				}
 			}
 		}
 	}
 }
+
+// parseRuleCounters fills the untagged Bytes and Packets counter fields of a firewall rule.  They are
+// kept out of the tik tags so that they are never sent back to the router on add or used in queries.
+func parseRuleCounters(props map[string]string, i interface{}) {
+	dstObj := reflect.ValueOf(i).Elem()
+	for _, name := range []string{"Bytes", "Packets"} {
+		if f := dstObj.FieldByName(name); f.IsValid() && f.Kind() == reflect.Int64 {
+			f.SetInt(parseInt64(props[strings.ToLower(name)]))
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package gotik
+
+import "context"
+
+// Pending is a command that has been sent to the RouterOS device but whose reply
+// may not have arrived yet.  It is returned by RunAsync() and RunArgsAsync().
+type Pending struct {
+	c    *Client
+	a    *asyncReply
+	err  error
+	done chan struct{}
+}
+
+// RunAsync simply calls RunArgsAsync().
+func (c *Client) RunAsync(sentence ...string) *Pending {
+	return c.RunArgsAsync(sentence)
+}
+
+// RunArgsAsync sends a sentence to the RouterOS device and returns immediately without
+// waiting for the reply.  If the client is not yet in async mode, Async() is started.
+// Several commands may be issued this way on one connection and waited on later:
+//
+//	res := c.RunAsync("/system/resource/print")
+//	intf := c.RunAsync("/interface/print")
+//	r, err := res.Wait(ctx)
+func (c *Client) RunArgsAsync(sentence []string) *Pending {
+	if !c.async {
+		c.Async()
+	}
+	p := &Pending{c: c, done: make(chan struct{})}
+	if r, handled, err := c.intercept(sentence); handled {
+		p.a = &asyncReply{}
+		if r != nil {
+			p.a.Reply = *r
+		}
+		p.err = err
+		close(p.done)
+		return p
+	}
+	a, err := c.sendAsync(sentence)
+	if err != nil {
+		p.err = err
+		close(p.done)
+		return p
+	}
+	p.a = a
+	go func() {
+		for range a.reC {
+		}
+		p.err = a.err
+		c.afterCommand(sentence, &a.Reply, a.err)
+		close(p.done)
+	}()
+	return p
+}
+
+// Done returns a channel which is closed once the reply has been fully received or the command failed.
+func (p *Pending) Done() <-chan struct{} {
+	return p.done
+}
+
+// Wait waits for the reply to the command.  If ctx is done first, ctx.Err() is returned
+// and the command continues to run on the device; call Cancel() to stop it.
+func (p *Pending) Wait(ctx context.Context) (*Reply, error) {
+	select {
+	case <-p.done:
+		if p.err != nil {
+			return nil, p.err
+		}
+		return &p.a.Reply, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Cancel sends a cancel command to the RouterOS device for this command.  The reply
+// to the command will then complete with an "interrupted" error.  Cancelling a command
+// which has already completed is a null but successful operation.
+func (p *Pending) Cancel() error {
+	select {
+	case <-p.done:
+		return nil
+	default:
+	}
+	_, err := p.c.Run("/cancel", "=tag="+p.a.tag)
+	return err
+}
@@ -0,0 +1,66 @@
+package gotik_test
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRunAsyncPending(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+
+	go func() {
+		defer s.Close()
+		s.readSentence(t, "/system/resource/print @r1 []")
+		s.readSentence(t, "/interface/print @r2 []")
+		s.writeSentence(t, "!re", ".tag=r2", "=name=ether1")
+		s.writeSentence(t, "!done", ".tag=r2")
+		s.writeSentence(t, "!re", ".tag=r1", "=uptime=1d")
+		s.writeSentence(t, "!done", ".tag=r1")
+	}()
+
+	res := c.RunAsync("/system/resource/print")
+	intf := c.RunAsync("/interface/print")
+
+	ctx := context.Background()
+	r, err := intf.Wait(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "!re @r2 [{`name` `ether1`}]\n!done @r2 []"
+	if r.String() != want {
+		t.Fatalf("/interface/print (%s); want (%s)", r, want)
+	}
+	r, err = res.Wait(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = "!re @r1 [{`uptime` `1d`}]\n!done @r1 []"
+	if r.String() != want {
+		t.Fatalf("/system/resource/print (%s); want (%s)", r, want)
+	}
+	select {
+	case <-res.Done():
+	default:
+		t.Fatal("Done() channel should be closed after Wait()")
+	}
+}
+
+func TestRunAsyncWaitTimeout(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+	defer s.Close()
+
+	go func() {
+		s.readSentence(t, "/tool/torch @r1 [{`interface` `ether1`}]")
+	}()
+
+	p := c.RunAsync("/tool/torch", "=interface=ether1")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := p.Wait(ctx)
+	if err != context.DeadlineExceeded {
+		t.Fatalf("Wait()=%v; want %v", err, context.DeadlineExceeded)
+	}
+}
@@ -0,0 +1,180 @@
+package gotik
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PortRange is an inclusive range of ports.  A single port has From == To.
+type PortRange struct {
+	From int
+	To   int
+}
+
+// PortSet is a set of ports as RouterOS writes them in src-port, dst-port, port and to-ports:
+// a single port (80), a range (8000-8100) or a comma separated list of either (80,443,8000-8100),
+// optionally negated with a leading "!".  The zero value is the empty set, which leaves the
+// property unset and so matches any port.
+type PortSet struct {
+	Ranges []PortRange
+	Negate bool
+}
+
+// Ports returns the set of the given ports.
+func Ports(ports ...int) PortSet {
+	var p PortSet
+	for _, port := range ports {
+		p.Ranges = append(p.Ranges, PortRange{port, port})
+	}
+	return p
+}
+
+// PortsRange returns the set of the ports from through to.
+func PortsRange(from, to int) PortSet {
+	return PortSet{Ranges: []PortRange{{from, to}}}
+}
+
+// ParsePortSet parses a port set such as "80", "8000-8100", "80,443" or "!22".  An empty string
+// returns the zero PortSet.
+func ParsePortSet(s string) (PortSet, error) {
+	var p PortSet
+	if len(s) == 0 {
+		return p, nil
+	}
+	if strings.HasPrefix(s, "!") {
+		p.Negate = true
+		s = s[1:]
+	}
+	for _, part := range strings.Split(s, ",") {
+		from, to, found := strings.Cut(strings.TrimSpace(part), "-")
+		if !found {
+			to = from
+		}
+		lo, err := strconv.Atoi(from)
+		if err != nil {
+			return PortSet{}, fmt.Errorf("invalid port set %q", s)
+		}
+		hi, err := strconv.Atoi(to)
+		if err != nil || lo < 0 || hi < lo || hi > 65535 {
+			return PortSet{}, fmt.Errorf("invalid port set %q", s)
+		}
+		p.Ranges = append(p.Ranges, PortRange{lo, hi})
+	}
+	return p, nil
+}
+
+// IsZero returns true for the empty set.
+func (p PortSet) IsZero() bool {
+	return len(p.Ranges) == 0
+}
+
+// String returns the set as RouterOS writes it, or "" for the empty set.
+func (p PortSet) String() string {
+	if p.IsZero() {
+		return ""
+	}
+	a := make([]string, 0, len(p.Ranges))
+	for _, r := range p.Ranges {
+		if r.From == r.To {
+			a = append(a, strconv.Itoa(r.From))
+		} else {
+			a = append(a, strconv.Itoa(r.From)+"-"+strconv.Itoa(r.To))
+		}
+	}
+	s := strings.Join(a, ",")
+	if p.Negate {
+		s = "!" + s
+	}
+	return s
+}
+
+// First returns the lowest port of the set, ignoring Negate, or 0 for the empty set.  This is
+// the port a to-ports set translates to.
+func (p PortSet) First() int {
+	first := 0
+	for i, r := range p.Ranges {
+		if i == 0 || r.From < first {
+			first = r.From
+		}
+	}
+	return first
+}
+
+// Contains returns true if a packet with the given port matches the set.  The empty set
+// matches every port.
+func (p PortSet) Contains(port int) bool {
+	if p.IsZero() {
+		return true
+	}
+	return rangesContain(p.Ranges, port) != p.Negate
+}
+
+// Covers returns true if every port matched by q is also matched by p.  It errs on the side of
+// false when p is a plain set and q is negated.
+func (p PortSet) Covers(q PortSet) bool {
+	switch {
+	case p.IsZero():
+		return true
+	case q.IsZero():
+		return false
+	case !p.Negate && !q.Negate:
+		return rangesWithin(q.Ranges, p.Ranges)
+	case p.Negate && q.Negate:
+		// q leaves out at least everything p leaves out
+		return rangesWithin(p.Ranges, q.Ranges)
+	case p.Negate:
+		// none of the ports of q are left out by p
+		for _, r := range q.Ranges {
+			for _, x := range p.Ranges {
+				if r.From <= x.To && x.From <= r.To {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func rangesContain(ranges []PortRange, port int) bool {
+	for _, r := range ranges {
+		if port >= r.From && port <= r.To {
+			return true
+		}
+	}
+	return false
+}
+
+// rangesWithin returns true if every port of inner is in outer.
+func rangesWithin(inner, outer []PortRange) bool {
+	merged := make([]PortRange, len(outer))
+	copy(merged, outer)
+	sort.Slice(merged, func(i, j int) bool { return merged[i].From < merged[j].From })
+	n := 0
+	for _, r := range merged {
+		if n > 0 && r.From <= merged[n-1].To+1 {
+			if r.To > merged[n-1].To {
+				merged[n-1].To = r.To
+			}
+			continue
+		}
+		merged[n] = r
+		n++
+	}
+	merged = merged[:n]
+	for _, r := range inner {
+		in := false
+		for _, m := range merged {
+			if r.From >= m.From && r.To <= m.To {
+				in = true
+				break
+			}
+		}
+		if !in {
+			return false
+		}
+	}
+	return true
+}
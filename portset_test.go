@@ -0,0 +1,65 @@
+package gotik_test
+
+import (
+	"testing"
+
+	"github.com/jjcinaz/gotik"
+)
+
+func TestParsePortSet(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		port    int
+		matches bool
+	}{
+		{"80", "80", 80, true},
+		{"8000-8100", "8000-8100", 8050, true},
+		{"80,443,8000-8100", "80,443,8000-8100", 443, true},
+		{"80,443", "80,443", 8080, false},
+		{"!22", "!22", 22, false},
+		{"!22", "!22", 23, true},
+		{"", "", 1234, true},
+	}
+	for _, tt := range tests {
+		p, err := gotik.ParsePortSet(tt.in)
+		if err != nil {
+			t.Fatalf("%q: %v", tt.in, err)
+		}
+		if p.String() != tt.want {
+			t.Errorf("%q: String() = %q; want %q", tt.in, p.String(), tt.want)
+		}
+		if p.Contains(tt.port) != tt.matches {
+			t.Errorf("%q: Contains(%d) = %v; want %v", tt.in, tt.port, !tt.matches, tt.matches)
+		}
+	}
+	for _, bad := range []string{"http", "100-90", "70000", "80,"} {
+		if _, err := gotik.ParsePortSet(bad); err == nil {
+			t.Errorf("%q: no error", bad)
+		}
+	}
+}
+
+func TestPortSetCovers(t *testing.T) {
+	tests := []struct {
+		p, q string
+		want bool
+	}{
+		{"", "80", true},
+		{"80", "", false},
+		{"1-1024", "22,80,443", true},
+		{"1-100,101-200", "50-150", true},
+		{"80,443", "80-443", false},
+		{"!22", "80,443", true},
+		{"!20-30", "25", false},
+		{"!22", "!20-30", true},
+		{"80", "!22", false},
+	}
+	for _, tt := range tests {
+		p, _ := gotik.ParsePortSet(tt.p)
+		q, _ := gotik.ParsePortSet(tt.q)
+		if got := p.Covers(q); got != tt.want {
+			t.Errorf("%q covers %q = %v; want %v", tt.p, tt.q, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package gotik
+
+func (c *Client) GetIPv4Raw(chain string) ([]IPv4RawRule, error) {
+	return getRules[IPv4RawRule](c, "/ip/firewall/raw", chain)
+}
+
+func (c *Client) AddIPv4RawRule(rule IPv4RawRule) (string, error) {
+	return addRule(c, "/ip/firewall/raw", rule.Chain, &rule)
+}
+
+func (c *Client) RemoveIPv4RawRule(id string) error {
+	return c.ruleCommand("/ip/firewall/raw", "remove", id)
+}
+
+func (c *Client) EnableIPv4RawRule(id string) error {
+	return c.ruleCommand("/ip/firewall/raw", "enable", id)
+}
+
+func (c *Client) DisableIPv4RawRule(id string) error {
+	return c.ruleCommand("/ip/firewall/raw", "disable", id)
+}
+
+func (r *IPv4RawRule) String() string {
+	return ruleString(r)
+}
@@ -0,0 +1,47 @@
+package gotik_test
+
+import (
+	"testing"
+
+	"github.com/jjcinaz/gotik"
+)
+
+func TestReadOnly(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+
+	go func() {
+		defer s.Close()
+		s.readSentence(t, "/system/identity/print @ []")
+		s.writeSentence(t, "!re", "=name=router1")
+		s.writeSentence(t, "!done")
+	}()
+
+	c.SetReadOnly(true)
+	for i, sentence := range [][]string{
+		{"/ip/firewall/filter/remove", "=.id=*1"},
+		{"/system/reboot"},
+		{"/system/package/update/install"},
+		{"/system/reset-configuration", "=no-defaults=yes"},
+		{"/import", "=file-name=config.rsc"},
+		{"/password", "=old-password=a", "=new-password=b", "=confirm-new-password=b"},
+		{"/ip/dhcp-server/lease/make-static", "=numbers=*1"},
+		{"/certificate/sign", "=.id=*1"},
+		{"/ip/firewall/filter/reset-counters", "=numbers=*1"},
+		{"/system/backup/save", "=name=b"},
+	} {
+		if _, err := c.RunArgs(sentence); err != gotik.ErrReadOnly {
+			t.Errorf("#%d: %#q=%v; want %v", i, sentence, err, gotik.ErrReadOnly)
+		}
+	}
+	if _, err := c.InstallUpdates(); err != gotik.ErrReadOnly {
+		t.Errorf("InstallUpdates()=%v; want %v", err, gotik.ErrReadOnly)
+	}
+	name, err := c.GetSystemId()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "router1" {
+		t.Fatalf("GetSystemId()=%s; want router1", name)
+	}
+}
@@ -0,0 +1,201 @@
+package gotik
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ReconcileChange is one change proposed or made by Reconcile.
+type ReconcileChange struct {
+	Operation rune   // A=Add, U=Update, D=Delete
+	Key       string // the key of the item, as returned by the key function
+	ID        string // the ID of the item on the router, empty for an add
+	Fields    []FieldChange
+}
+
+// FieldChange is a property whose value on the router differs from the desired value.
+type FieldChange struct {
+	Field string // the tik tag, for example "comment"
+	Old   string
+	New   string
+}
+
+func (e *ReconcileChange) String() string {
+	s := fmt.Sprintf("%c: %s %s", e.Operation, e.ID, e.Key)
+	for _, f := range e.Fields {
+		s += fmt.Sprintf(" %s=%q->%q", f.Field, f.Old, f.New)
+	}
+	return s
+}
+
+// reconcileSkip are the tik tags which Reconcile never compares or sends.
+var reconcileSkip = map[string]bool{
+	".id":            true,
+	"place-before":   true,
+	"dynamic":        true,
+	"invalid":        true,
+	"default":        true,
+	"last-logged-in": true,
+	"creation-time":  true,
+}
+
+// Reconcile makes the items in a menu of the router match desired, in the manner of
+// AuditIPv4AddressList but for any struct type with tik tags, such as User, SNMPCommunity or
+// RadiusServer.  T must be a struct; key returns the identity of an item, for example its
+// name.  The menu is given by location, for example "/snmp/community", or if location is
+// empty, by the tik tag of a RouterLocation field of T.
+//
+// The current items are printed, and dynamic items and the router's default items (those
+// printed with default=true, such as the public SNMP community) are ignored.  An item on the router whose key
+// is not desired is deleted, a desired item which is not on the router is added, and an item on
+// both with differing fields is updated.  Bool fields are always compared; other fields are
+// compared only if they are set (non-zero) in the desired item, so that properties left to
+// their defaults do not cause updates.  Values the router does not print, such as passwords,
+// always appear changed and so should be left empty.
+//
+// applyChanges must be true in order for changes to actually be applied to the router, else
+// only proposed changes will be returned.
+func Reconcile[T any](c *Client, location string, desired []T, key func(*T) string, applyChanges bool) ([]ReconcileChange, error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("reconcile: %s is not a struct", t)
+	}
+	if len(location) == 0 {
+		if f, ok := t.FieldByName("RouterLocation"); ok {
+			location = f.Tag.Get("tik")
+		}
+		if len(location) == 0 {
+			return nil, errors.New("no RouterLocation field in structure")
+		}
+	}
+
+	detail, err := c.Run(location + "/print")
+	if err != nil {
+		return nil, err
+	}
+	want := make(map[string]*T, len(desired))
+	order := make([]string, 0, len(desired))
+	for i := range desired {
+		k := key(&desired[i])
+		if _, dup := want[k]; dup {
+			return nil, fmt.Errorf("reconcile: duplicate key %q in desired items", k)
+		}
+		want[k] = &desired[i]
+		order = append(order, k)
+	}
+
+	changes := make([]ReconcileChange, 0, len(desired))
+	seen := make(map[string]bool, len(desired))
+	for _, re := range detail.Re {
+		if parseBool(re.Map["dynamic"]) || parseBool(re.Map["default"]) {
+			continue
+		}
+		var current T
+		parseTikObject(re.Map, &current)
+		k := key(&current)
+		d, found := want[k]
+		if !found || seen[k] {
+			changes = append(changes, ReconcileChange{Operation: 'D', Key: k, ID: re.Map[".id"]})
+			continue
+		}
+		seen[k] = true
+		if fields := fieldChanges(re.Map, reflect.ValueOf(d).Elem()); len(fields) > 0 {
+			changes = append(changes, ReconcileChange{Operation: 'U', Key: k, ID: re.Map[".id"], Fields: fields})
+		}
+	}
+	for _, k := range order {
+		if !seen[k] {
+			changes = append(changes, ReconcileChange{Operation: 'A', Key: k, Fields: fieldChanges(nil, reflect.ValueOf(want[k]).Elem())})
+		}
+	}
+
+	if applyChanges {
+		for _, e := range changes {
+			switch e.Operation {
+			case 'A':
+				_, err = c.Run(fieldSentence(location+"/add", e.Fields)...)
+			case 'U':
+				_, err = c.Run(fieldSentence(location+"/set", e.Fields, "=.id="+e.ID)...)
+			case 'D':
+				_, err = c.Run(location+"/remove", "=.id="+e.ID)
+			}
+			if err != nil {
+				break
+			}
+		}
+	}
+	return changes, err
+}
+
+// fieldChanges compares the tik tagged fields of the struct v with the properties printed by
+// the router.  props is nil for an item which does not exist yet.
+func fieldChanges(props map[string]string, v reflect.Value) []FieldChange {
+	var fields []FieldChange
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("tik")
+		if len(tag) == 0 || strings.HasPrefix(tag, "/") || reconcileSkip[tag] {
+			continue
+		}
+		value, managed := formatTikValue(v.Field(i))
+		if !managed {
+			continue
+		}
+		old := props[tag]
+		if props != nil && tikValueEqual(v.Field(i), old, value) {
+			continue
+		}
+		if props == nil && field.Type.Kind() == reflect.Bool && !v.Field(i).Bool() {
+			// false is the default of a new item
+			continue
+		}
+		fields = append(fields, FieldChange{Field: tag, Old: old, New: value})
+	}
+	return fields
+}
+
+// formatTikValue returns the field value as the API expects it.  managed is false for fields
+// which are left at their zero value, other than bools, and for unsupported types.
+func formatTikValue(v reflect.Value) (value string, managed bool) {
+	switch x := v.Interface().(type) {
+	case bool:
+		return strconv.FormatBool(x), true
+	case string:
+		return x, len(x) > 0
+	case int:
+		return strconv.Itoa(x), x != 0
+	case time.Duration:
+		return x.Round(time.Second).String(), x != 0
+	case []string:
+		return strings.Join(x, ","), len(x) > 0
+	case PortSet:
+		return x.String(), !x.IsZero()
+	}
+	return "", false
+}
+
+// tikValueEqual compares a printed value with the formatted value of field v.
+func tikValueEqual(v reflect.Value, printed, value string) bool {
+	switch x := v.Interface().(type) {
+	case bool:
+		return parseBool(printed) == x
+	case int:
+		return parseInt(printed) == x
+	case time.Duration:
+		return parseDuration(printed) == x.Round(time.Second)
+	}
+	return printed == value
+}
+
+func fieldSentence(cmd string, fields []FieldChange, extra ...string) []string {
+	sentence := append([]string{cmd}, extra...)
+	for _, f := range fields {
+		sentence = append(sentence, "="+f.Field+"="+f.New)
+	}
+	return sentence
+}
@@ -0,0 +1,69 @@
+package gotik_test
+
+import (
+	"testing"
+
+	"github.com/jjcinaz/gotik"
+)
+
+func TestReconcile(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+
+	go func() {
+		defer s.Close()
+		for i := 0; i < 2; i++ {
+			s.readSentence(t, "/snmp/community/print @ []")
+			s.writeSentence(t, "!re", "=.id=*0", "=name=public", "=addresses=::/0", "=read-access=true", "=default=true")
+			s.writeSentence(t, "!re", "=.id=*1", "=name=monitor", "=addresses=10.0.0.0/8", "=read-access=true", "=write-access=false")
+			s.writeSentence(t, "!re", "=.id=*2", "=name=dyn", "=dynamic=true")
+			s.writeSentence(t, "!re", "=.id=*3", "=name=old", "=read-access=true")
+			s.writeSentence(t, "!done")
+		}
+		s.readSentence(t, "/snmp/community/set @ [{`.id` `*1`} {`addresses` `10.0.0.0/8,192.168.0.0/16`}]")
+		s.writeSentence(t, "!done")
+		s.readSentence(t, "/snmp/community/remove @ [{`.id` `*3`}]")
+		s.writeSentence(t, "!done")
+		s.readSentence(t, "/snmp/community/add @ [{`name` `backup`} {`read-access` `true`}]")
+		s.writeSentence(t, "!done", "=ret=*4")
+	}()
+
+	desired := []gotik.SNMPCommunity{
+		{Name: "monitor", Addresses: []string{"10.0.0.0/8", "192.168.0.0/16"}, ReadAccess: true},
+		{Name: "backup", ReadAccess: true},
+	}
+	key := func(e *gotik.SNMPCommunity) string { return e.Name }
+
+	changes, err := gotik.Reconcile(c, "/snmp/community", desired, key, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		`U: *1 monitor addresses="10.0.0.0/8"->"10.0.0.0/8,192.168.0.0/16"`,
+		`D: *3 old`,
+		`A:  backup name=""->"backup" read-access=""->"true"`,
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("%d changes; want %d: %v", len(changes), len(want), changes)
+	}
+	for i := range want {
+		if got := changes[i].String(); got != want[i] {
+			t.Errorf("change %d: %s; want %s", i, got, want[i])
+		}
+	}
+
+	if _, err = gotik.Reconcile(c, "/snmp/community", desired, key, true); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReconcileNoLocation(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+	defer s.Close()
+
+	_, err := gotik.Reconcile(c, "", []gotik.User{{Name: "bob"}}, func(u *gotik.User) string { return u.Name }, false)
+	if err == nil {
+		t.Fatal("Reconcile() succeeded without a location; want error")
+	}
+}
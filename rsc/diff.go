@@ -0,0 +1,365 @@
+package rsc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jjcinaz/gotik/internal/order"
+)
+
+// ChangeKind is the kind of an ItemChange.
+type ChangeKind int
+
+const (
+	Added ChangeKind = iota
+	Removed
+	Changed
+	Moved // the item is unchanged, or changed, but its position in an ordered chain differs
+)
+
+func (k ChangeKind) String() string {
+	switch k {
+	case Added:
+		return "added"
+	case Removed:
+		return "removed"
+	case Changed:
+		return "changed"
+	case Moved:
+		return "moved"
+	}
+	return fmt.Sprintf("ChangeKind(%d)", int(k))
+}
+
+// PropChange is a property whose value differs.  Old or New is empty if the property is
+// only present on one side.
+type PropChange struct {
+	Key string
+	Old string
+	New string
+}
+
+// ItemChange is a difference between two configurations in one item.
+type ItemChange struct {
+	Menu  string // menu path in export form, for example "/ip firewall filter"
+	Kind  ChangeKind
+	Key   string // the natural key by which the item was matched, for example "name=bridge1"
+	Old   *Item  // nil if added
+	New   *Item  // nil if removed
+	Props []PropChange
+}
+
+func (c ItemChange) String() string {
+	switch c.Kind {
+	case Added:
+		return fmt.Sprintf("%s: + %s", c.Menu, c.New)
+	case Removed:
+		return fmt.Sprintf("%s: - %s", c.Menu, c.Old)
+	}
+	parts := make([]string, 0, len(c.Props))
+	for _, p := range c.Props {
+		parts = append(parts, fmt.Sprintf("%s: %s -> %s", p.Key, Quote(p.Old), Quote(p.New)))
+	}
+	s := fmt.Sprintf("%s: %s %s", c.Menu, c.Kind, c.Key)
+	if len(parts) > 0 {
+		s += " (" + strings.Join(parts, ", ") + ")"
+	}
+	return s
+}
+
+// Options control how items are compared.
+type Options struct {
+	// Ignore lists properties which are never compared.
+	Ignore map[string]bool
+	// Defaults gives the default values of properties.  A property set to its default is
+	// treated the same as a property which is absent.
+	Defaults map[string]string
+}
+
+// DefaultOptions treats disabled=no as absent, since exports only sometimes include it.
+var DefaultOptions = Options{
+	Defaults: map[string]string{"disabled": "no"},
+}
+
+// orderedMenus are the menus whose items are processed in order within each chain.
+var orderedMenus = map[string]bool{
+	"/ip/firewall/filter":   true,
+	"/ip/firewall/nat":      true,
+	"/ip/firewall/mangle":   true,
+	"/ip/firewall/raw":      true,
+	"/ipv6/firewall/filter": true,
+	"/ipv6/firewall/nat":    true,
+	"/ipv6/firewall/mangle": true,
+	"/ipv6/firewall/raw":    true,
+}
+
+// Compare returns the differences between old and new using DefaultOptions.
+func Compare(old, new *Config) []ItemChange {
+	return CompareWithOptions(old, new, DefaultOptions)
+}
+
+// CompareWithOptions returns the differences between old and new, menu by menu.
+//
+// Items are matched by a natural key rather than by position: the find selector or arguments
+// of a set, otherwise the name, the list and address of an address list entry, the address,
+// or the comment.  Items with none of these are matched only if all of their properties are
+// equal.  In the firewall menus, items are matched within each chain, first by comment, then
+// by equal properties, then by position, and items whose order in the chain changed are
+// reported as Moved.
+func CompareWithOptions(old, new *Config, opts Options) []ItemChange {
+	var changes []ItemChange
+	for _, path := range menuPaths(old, new) {
+		oldItems, newItems := old.Items(path), new.Items(path)
+		name := exportPath(old, new, path)
+		if orderedMenus[path] {
+			changes = append(changes, compareOrdered(name, oldItems, newItems, opts)...)
+		} else {
+			changes = append(changes, compareKeyed(name, path, oldItems, newItems, opts)...)
+		}
+	}
+	return changes
+}
+
+// menuPaths returns the API form of every menu path in old or new, in order of appearance.
+func menuPaths(old, new *Config) []string {
+	var paths []string
+	seen := make(map[string]bool)
+	for _, c := range []*Config{old, new} {
+		for _, m := range c.Menus {
+			if p := m.APIPath(); !seen[p] {
+				seen[p] = true
+				paths = append(paths, p)
+			}
+		}
+	}
+	return paths
+}
+
+func exportPath(old, new *Config, path string) string {
+	if m := old.Menu(path); m != nil {
+		return m.Path
+	}
+	return new.Menu(path).Path
+}
+
+// naturalKey returns the key by which an item in an unordered menu is matched.
+func naturalKey(path string, item *Item, opts Options) string {
+	if len(item.Raw) > 0 {
+		return item.Raw
+	}
+	if len(item.Find) > 0 {
+		return item.Command + " [ find " + item.Find + " ]"
+	}
+	if len(item.Args) > 0 {
+		return item.Command + " " + strings.Join(item.Args, " ")
+	}
+	if item.Command != "add" {
+		return item.Command
+	}
+	m := item.Map()
+	if v, ok := m["name"]; ok {
+		return "name=" + v
+	}
+	if strings.HasSuffix(path, "/address-list") {
+		return "list=" + m["list"] + " address=" + m["address"]
+	}
+	for _, k := range []string{"address", "comment"} {
+		if v, ok := m[k]; ok {
+			return k + "=" + v
+		}
+	}
+	return "add " + normalized(item, opts)
+}
+
+// normalized returns the properties of item which are compared, sorted, as a single string.
+func normalized(item *Item, opts Options) string {
+	if len(item.Raw) > 0 {
+		return item.Raw
+	}
+	m := compared(item, opts)
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	words := make([]string, len(keys))
+	for i, k := range keys {
+		words[i] = k + "=" + Quote(m[k])
+	}
+	return strings.Join(words, " ")
+}
+
+// compared returns the properties of item which are compared.
+func compared(item *Item, opts Options) map[string]string {
+	m := make(map[string]string, len(item.Props))
+	for _, p := range item.Props {
+		if opts.Ignore[p.Key] {
+			continue
+		}
+		if d, ok := opts.Defaults[p.Key]; ok && d == p.Value {
+			continue
+		}
+		m[p.Key] = p.Value
+	}
+	return m
+}
+
+// propChanges returns the properties which differ between old and new, sorted by key.
+func propChanges(old, new *Item, opts Options) []PropChange {
+	o, n := compared(old, opts), compared(new, opts)
+	var changes []PropChange
+	for k, ov := range o {
+		if nv, ok := n[k]; !ok || nv != ov {
+			changes = append(changes, PropChange{Key: k, Old: ov, New: nv})
+		}
+	}
+	for k, nv := range n {
+		if _, ok := o[k]; !ok {
+			changes = append(changes, PropChange{Key: k, New: nv})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Key < changes[j].Key })
+	return changes
+}
+
+func compareKeyed(name, path string, oldItems, newItems []*Item, opts Options) []ItemChange {
+	var changes []ItemChange
+	newByKey := make(map[string][]*Item)
+	for _, item := range newItems {
+		k := naturalKey(path, item, opts)
+		newByKey[k] = append(newByKey[k], item)
+	}
+	matched := make(map[*Item]bool)
+	for _, o := range oldItems {
+		k := naturalKey(path, o, opts)
+		candidates := newByKey[k]
+		if len(candidates) == 0 {
+			changes = append(changes, ItemChange{Menu: name, Kind: Removed, Key: k, Old: o})
+			continue
+		}
+		n := candidates[0]
+		newByKey[k] = candidates[1:]
+		matched[n] = true
+		if props := propChanges(o, n, opts); len(props) > 0 {
+			changes = append(changes, ItemChange{Menu: name, Kind: Changed, Key: k, Old: o, New: n, Props: props})
+		}
+	}
+	for _, n := range newItems {
+		if !matched[n] {
+			changes = append(changes, ItemChange{Menu: name, Kind: Added, Key: naturalKey(path, n, opts), New: n})
+		}
+	}
+	return changes
+}
+
+func compareOrdered(name string, oldItems, newItems []*Item, opts Options) []ItemChange {
+	var (
+		changes []ItemChange
+		chains  []string
+	)
+	oldChains, newChains := make(map[string][]*Item), make(map[string][]*Item)
+	for _, item := range oldItems {
+		ch, _ := item.Get("chain")
+		if _, ok := oldChains[ch]; !ok {
+			chains = append(chains, ch)
+		}
+		oldChains[ch] = append(oldChains[ch], item)
+	}
+	for _, item := range newItems {
+		ch, _ := item.Get("chain")
+		if _, ok := oldChains[ch]; !ok {
+			if _, ok = newChains[ch]; !ok {
+				chains = append(chains, ch)
+			}
+		}
+		newChains[ch] = append(newChains[ch], item)
+	}
+	for _, ch := range chains {
+		changes = append(changes, compareChain(name, ch, oldChains[ch], newChains[ch], opts)...)
+	}
+	return changes
+}
+
+// compareChain compares the rules of one chain.
+func compareChain(name, chain string, oldItems, newItems []*Item, opts Options) []ItemChange {
+	match := make([]int, len(oldItems)) // index in newItems of the match of each old item, or -1
+	taken := make([]bool, len(newItems))
+	for i := range match {
+		match[i] = -1
+	}
+	pair := func(same func(o, n *Item) bool) {
+		for i, o := range oldItems {
+			if match[i] >= 0 {
+				continue
+			}
+			for j, n := range newItems {
+				if !taken[j] && same(o, n) {
+					match[i], taken[j] = j, true
+					break
+				}
+			}
+		}
+	}
+	oldComments, newComments := commentCounts(oldItems), commentCounts(newItems)
+	pair(func(o, n *Item) bool {
+		c, ok := o.Get("comment")
+		nc, _ := n.Get("comment")
+		return ok && c == nc && oldComments[c] == 1 && newComments[c] == 1
+	})
+	pair(func(o, n *Item) bool { return normalized(o, opts) == normalized(n, opts) })
+	// pair what remains by position among the unmatched rules
+	var freeOld, freeNew []int
+	for i := range oldItems {
+		if match[i] < 0 {
+			freeOld = append(freeOld, i)
+		}
+	}
+	for j := range newItems {
+		if !taken[j] {
+			freeNew = append(freeNew, j)
+		}
+	}
+	for k := 0; k < len(freeOld) && k < len(freeNew); k++ {
+		match[freeOld[k]], taken[freeNew[k]] = freeNew[k], true
+	}
+
+	inOrder := order.LongestIncreasing(match)
+	key := func(item *Item, pos int) string {
+		if c, ok := item.Get("comment"); ok {
+			return fmt.Sprintf("chain=%s comment=%s", chain, Quote(c))
+		}
+		return fmt.Sprintf("chain=%s #%d", chain, pos)
+	}
+	var changes []ItemChange
+	for i, o := range oldItems {
+		j := match[i]
+		if j < 0 {
+			changes = append(changes, ItemChange{Menu: name, Kind: Removed, Key: key(o, i), Old: o})
+			continue
+		}
+		props := propChanges(o, newItems[j], opts)
+		switch {
+		case !inOrder[i]:
+			changes = append(changes, ItemChange{Menu: name, Kind: Moved, Key: key(o, i), Old: o, New: newItems[j], Props: props})
+		case len(props) > 0:
+			changes = append(changes, ItemChange{Menu: name, Kind: Changed, Key: key(o, i), Old: o, New: newItems[j], Props: props})
+		}
+	}
+	for j, n := range newItems {
+		if !taken[j] {
+			changes = append(changes, ItemChange{Menu: name, Kind: Added, Key: key(n, j), New: n})
+		}
+	}
+	return changes
+}
+
+func commentCounts(items []*Item) map[string]int {
+	counts := make(map[string]int)
+	for _, item := range items {
+		if c, ok := item.Get("comment"); ok {
+			counts[c]++
+		}
+	}
+	return counts
+}
@@ -0,0 +1,77 @@
+package rsc
+
+import (
+	"testing"
+)
+
+func mustParse(t *testing.T, s string) *Config {
+	c, err := ParseString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestCompare(t *testing.T) {
+	old := mustParse(t, `# 2024-01-02 10:00:00 by RouterOS 7.12
+/interface bridge
+add name=bridge1
+add name=bridge2 mtu=1500
+/ip address
+add address=10.0.0.1/24 interface=ether1
+/ip firewall address-list
+add address=1.2.3.4 list=blocked
+/ip firewall filter
+add action=accept chain=input comment=established connection-state=established
+add action=accept chain=input comment=icmp protocol=icmp
+add action=drop chain=input comment="drop rest"
+add action=accept chain=forward
+/system identity
+set name=router1
+`)
+	new := mustParse(t, `# 2024-02-03 11:00:00 by RouterOS 7.13
+/interface bridge
+add name=bridge2 mtu=9000
+add disabled=no name=bridge1
+/ip address
+add address=10.0.0.1/24 interface=bridge1
+/ip firewall address-list
+add address=1.2.3.4 list=blocked
+add address=5.6.7.8 list=blocked
+/ip firewall filter
+add action=accept chain=input comment=icmp protocol=icmp
+add action=accept chain=input comment=established connection-state=established
+add action=accept chain=input comment=ssh dst-port=22 protocol=tcp
+add action=drop chain=input comment="drop rest"
+/system identity
+set name=router2
+`)
+	want := []string{
+		"/interface bridge: changed name=bridge2 (mtu: 1500 -> 9000)",
+		"/ip address: changed address=10.0.0.1/24 (interface: ether1 -> bridge1)",
+		"/ip firewall address-list: + add address=5.6.7.8 list=blocked",
+		"/ip firewall filter: moved chain=input comment=established",
+		"/ip firewall filter: + add action=accept chain=input comment=ssh dst-port=22 protocol=tcp",
+		"/ip firewall filter: - add action=accept chain=forward",
+		"/system identity: changed set (name: router1 -> router2)",
+	}
+	changes := Compare(old, new)
+	if len(changes) != len(want) {
+		for _, c := range changes {
+			t.Log(c)
+		}
+		t.Fatalf("%d changes; want %d", len(changes), len(want))
+	}
+	for i := range want {
+		if got := changes[i].String(); got != want[i] {
+			t.Errorf("change %d: %s; want %s", i, got, want[i])
+		}
+	}
+}
+
+func TestCompareIdentical(t *testing.T) {
+	c := mustParse(t, "/ip firewall filter\nadd action=accept chain=input\nadd action=drop chain=input\n")
+	if changes := Compare(c, c); len(changes) != 0 {
+		t.Fatalf("Compare() of a config with itself returned %v", changes)
+	}
+}
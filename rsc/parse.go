@@ -0,0 +1,384 @@
+package rsc
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ParseError reports a syntax error in a script.
+type ParseError struct {
+	Line int
+	Msg  string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("rsc: line %d: %s", e.Line, e.Msg)
+}
+
+// commands are the words which end the path of a menu line and start a command on the same line.
+var commands = map[string]bool{
+	"add":     true,
+	"set":     true,
+	"remove":  true,
+	"enable":  true,
+	"disable": true,
+	"move":    true,
+	"unset":   true,
+	"comment": true,
+	"print":   true,
+	"export":  true,
+	"import":  true,
+	"run":     true,
+}
+
+// token is one word of a command line.  A key=value word has both key and value set and
+// hasValue true; any other word is held in key alone.
+type token struct {
+	key      string
+	value    string
+	hasValue bool
+	quoted   bool // the word, or its value, was quoted
+}
+
+// ParseString parses the script text s.
+func ParseString(s string) (*Config, error) {
+	return Parse(strings.NewReader(s))
+}
+
+// Parse reads and parses a script.  Backslash line continuations are joined, comments are
+// kept with the menu or item they precede and quoted strings are unescaped.  Scripting commands,
+// the lines starting with ":" such as ":do { ... } on-error={}", are kept as written in the Raw
+// field of an item, along with the following lines up to the end of their block.
+func Parse(r io.Reader) (*Config, error) {
+	c := &Config{}
+	var (
+		menu     *Menu
+		comments []string
+	)
+	lines, err := logicalLines(r)
+	if err != nil {
+		return nil, err
+	}
+	for n := 0; n < len(lines); n++ {
+		l := lines[n]
+		text := strings.TrimSpace(l.text)
+		if len(text) == 0 {
+			continue
+		}
+		if strings.HasPrefix(text, ":") {
+			// a scripting command such as :do, :if or :foreach, kept as written along with
+			// the lines up to the brace which closes its block
+			if menu == nil {
+				return nil, &ParseError{Line: l.number, Msg: "command before the first menu"}
+			}
+			raw := []string{text}
+			depth, err := braceDepth(text, 0)
+			for err == nil && depth > 0 && n+1 < len(lines) {
+				n++
+				raw = append(raw, lines[n].text)
+				depth, err = braceDepth(lines[n].text, depth)
+			}
+			if err == nil && depth != 0 {
+				err = fmt.Errorf("unbalanced braces")
+			}
+			if err != nil {
+				return nil, &ParseError{Line: l.number, Msg: err.Error()}
+			}
+			menu.Items = append(menu.Items, &Item{Raw: strings.Join(raw, "\n"), Comments: comments, Line: l.number})
+			comments = nil
+			continue
+		}
+		if strings.HasPrefix(text, "#") {
+			if menu == nil {
+				c.Header = append(c.Header, text[1:])
+			} else {
+				comments = append(comments, text[1:])
+			}
+			continue
+		}
+		tokens, err := tokenize(text)
+		if err != nil {
+			return nil, &ParseError{Line: l.number, Msg: err.Error()}
+		}
+		if strings.HasPrefix(text, "/") {
+			path, n := menuPath(tokens)
+			menu = &Menu{Path: path, Comments: comments}
+			comments = nil
+			c.Menus = append(c.Menus, menu)
+			tokens = tokens[n:]
+			if len(tokens) == 0 {
+				continue
+			}
+		}
+		if menu == nil {
+			return nil, &ParseError{Line: l.number, Msg: "command before the first menu"}
+		}
+		item, err := parseItem(tokens)
+		if err != nil {
+			return nil, &ParseError{Line: l.number, Msg: err.Error()}
+		}
+		item.Line = l.number
+		item.Comments = comments
+		comments = nil
+		menu.Items = append(menu.Items, item)
+	}
+	return c, nil
+}
+
+type line struct {
+	number int
+	text   string
+}
+
+// logicalLines reads r, joining lines which end in a backslash with the line after, less its
+// leading whitespace.
+func logicalLines(r io.Reader) ([]line, error) {
+	var (
+		lines   []line
+		current *line
+	)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	n := 0
+	for scanner.Scan() {
+		n++
+		text := strings.TrimRight(scanner.Text(), "\r")
+		if current != nil {
+			text = current.text + strings.TrimLeft(text, " \t")
+		} else {
+			current = &line{number: n}
+		}
+		if continued(text) {
+			current.text = text[:len(text)-1]
+			continue
+		}
+		current.text = text
+		lines = append(lines, *current)
+		current = nil
+	}
+	if current != nil {
+		lines = append(lines, *current)
+	}
+	return lines, scanner.Err()
+}
+
+// continued returns true if text ends in a backslash which is not itself escaped.
+func continued(text string) bool {
+	n := 0
+	for i := len(text) - 1; i >= 0 && text[i] == '\\'; i-- {
+		n++
+	}
+	return n%2 == 1
+}
+
+// braceDepth returns depth plus the number of braces text opens less the number it closes,
+// ignoring those within quoted strings.
+func braceDepth(text string, depth int) (int, error) {
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '"':
+			var err error
+			if _, i, err = unquote(text, i); err != nil {
+				return depth, err
+			}
+			i--
+		case '{':
+			depth++
+		case '}':
+			depth--
+		}
+	}
+	return depth, nil
+}
+
+// menuPath returns the path of a menu line and the number of tokens it used.
+func menuPath(tokens []token) (string, int) {
+	words := make([]string, 0, len(tokens))
+	n := 0
+	for _, t := range tokens {
+		if t.hasValue || t.quoted || commands[t.key] || t.key == "[" {
+			break
+		}
+		words = append(words, t.key)
+		n++
+	}
+	return strings.Join(words, " "), n
+}
+
+func parseItem(tokens []token) (*Item, error) {
+	if len(tokens) == 0 || tokens[0].hasValue {
+		return nil, fmt.Errorf("missing command")
+	}
+	item := &Item{Command: tokens[0].key}
+	tokens = tokens[1:]
+	if len(tokens) > 0 && !tokens[0].quoted && tokens[0].key == "[" {
+		end := -1
+		for i, t := range tokens {
+			if !t.quoted && !t.hasValue && t.key == "]" {
+				end = i
+				break
+			}
+		}
+		if end < 0 {
+			return nil, fmt.Errorf("missing ]")
+		}
+		find := tokens[1:end]
+		if len(find) == 0 || find[0].key != "find" || find[0].hasValue {
+			return nil, fmt.Errorf("unsupported selector")
+		}
+		words := make([]string, 0, len(find)-1)
+		for _, t := range find[1:] {
+			words = append(words, t.String())
+		}
+		item.Find = strings.Join(words, " ")
+		tokens = tokens[end+1:]
+	}
+	for _, t := range tokens {
+		if t.hasValue {
+			item.Props = append(item.Props, Prop{Key: t.key, Value: t.value})
+		} else {
+			item.Args = append(item.Args, t.key)
+		}
+	}
+	return item, nil
+}
+
+// String returns the token as it would be written in a script.
+func (t token) String() string {
+	if t.hasValue {
+		return t.key + "=" + Quote(t.value)
+	}
+	if t.quoted {
+		return Quote(t.key)
+	}
+	return t.key
+}
+
+// tokenize splits a logical line into words.  Brackets are words of their own.
+func tokenize(text string) ([]token, error) {
+	var tokens []token
+	i := 0
+	for i < len(text) {
+		ch := text[i]
+		if ch == ' ' || ch == '\t' {
+			i++
+			continue
+		}
+		if ch == '[' || ch == ']' {
+			tokens = append(tokens, token{key: string(ch)})
+			i++
+			continue
+		}
+		var (
+			t   token
+			b   strings.Builder
+			err error
+		)
+		for i < len(text) {
+			ch = text[i]
+			if ch == ' ' || ch == '\t' || ((ch == '[' || ch == ']') && !t.hasValue) {
+				break
+			}
+			switch {
+			case ch == '"':
+				var s string
+				if s, i, err = unquote(text, i); err != nil {
+					return nil, err
+				}
+				b.WriteString(s)
+				t.quoted = true
+				continue
+			case ch == '=' && !t.hasValue:
+				t.key = b.String()
+				t.hasValue = true
+				t.quoted = false
+				b.Reset()
+			case ch == '[' || ch == ']':
+				// a value such as [find ...] is not a selector; keep its brackets
+				depth := 0
+				for ; i < len(text); i++ {
+					if text[i] == '[' {
+						depth++
+					} else if text[i] == ']' {
+						depth--
+					}
+					b.WriteByte(text[i])
+					if depth == 0 {
+						break
+					}
+				}
+			default:
+				b.WriteByte(ch)
+			}
+			i++
+		}
+		if t.hasValue {
+			t.value = b.String()
+		} else {
+			t.key = b.String()
+		}
+		tokens = append(tokens, t)
+	}
+	return tokens, nil
+}
+
+// unquote decodes the quoted string starting at text[i] and returns it along with the index
+// just after the closing quote.
+func unquote(text string, i int) (string, int, error) {
+	var b strings.Builder
+	for i++; i < len(text); i++ {
+		ch := text[i]
+		if ch == '"' {
+			return b.String(), i + 1, nil
+		}
+		if ch != '\\' {
+			b.WriteByte(ch)
+			continue
+		}
+		i++
+		if i >= len(text) {
+			break
+		}
+		switch ch = text[i]; ch {
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 't':
+			b.WriteByte('\t')
+		case 'a':
+			b.WriteByte('\a')
+		case 'b':
+			b.WriteByte('\b')
+		case 'f':
+			b.WriteByte('\f')
+		case 'v':
+			b.WriteByte('\v')
+		case '_':
+			b.WriteByte(' ')
+		default:
+			if i+1 < len(text) && isHex(ch) && isHex(text[i+1]) {
+				b.WriteByte(unhex(ch)<<4 | unhex(text[i+1]))
+				i++
+			} else {
+				b.WriteByte(ch)
+			}
+		}
+	}
+	return "", i, fmt.Errorf("unterminated quoted string")
+}
+
+// isHex returns true for the digits of a \XX escape, which RouterOS writes in upper case so
+// that they cannot be confused with escapes such as \a and \b.
+func isHex(ch byte) bool {
+	return (ch >= '0' && ch <= '9') || (ch >= 'A' && ch <= 'F')
+}
+
+func unhex(ch byte) byte {
+	if ch <= '9' {
+		return ch - '0'
+	}
+	return ch - 'A' + 10
+}
@@ -0,0 +1,208 @@
+// Package rsc parses the script format of RouterOS configuration exports (.rsc files) into a
+// tree of menus and items, and writes such a tree back out as script text.
+//
+// An export such as
+//
+//	# 2024-01-02 10:00:00 by RouterOS 7.12
+//	/interface ethernet
+//	set [ find default-name=ether1 ] comment=WAN
+//	/ip firewall filter
+//	add action=accept chain=input comment="allow established" \
+//	    connection-state=established,related
+//
+// parses into two menus, "/interface ethernet" and "/ip firewall filter", each holding one item.
+package rsc
+
+import (
+	"bytes"
+	"io"
+	"strings"
+)
+
+// Config is a parsed export.
+type Config struct {
+	Header []string // comment lines before the first menu, without the leading "#"
+	Menus  []*Menu  // in the order they appear; a menu path may appear more than once
+}
+
+// Menu is a menu path line followed by the commands run in that menu.
+type Menu struct {
+	Path     string   // words separated by spaces, for example "/ip firewall filter"
+	Comments []string // comment lines just before the menu line, without the leading "#"
+	Items    []*Item
+}
+
+// Item is one command within a menu, for example an add or a set.
+type Item struct {
+	Command  string   // add, set, remove...
+	Find     string   // the expression of a [ find ... ] selector, for example "default-name=ether1"
+	Args     []string // words without a value, for example the "ether1" of "set ether1 mtu=1500"
+	Props    []Prop   // key=value words, in order
+	Comments []string // comment lines just before the command, without the leading "#"
+	Line     int      // line number where the command starts, zero if not parsed
+	Raw      string   // a scripting command such as ":do { ... }" as written; the fields above are then empty
+}
+
+// Prop is a key=value property of an item.
+type Prop struct {
+	Key   string
+	Value string // unquoted and unescaped
+}
+
+// APIPath returns the menu path in the form used by the API, for example "/ip/firewall/filter".
+func (m *Menu) APIPath() string {
+	return APIPath(m.Path)
+}
+
+// APIPath converts a menu path such as "/ip firewall filter" to the form used by the API,
+// "/ip/firewall/filter".  A path already in API form is returned unchanged.
+func APIPath(path string) string {
+	return "/" + strings.Join(strings.Fields(strings.ReplaceAll(strings.TrimPrefix(path, "/"), "/", " ")), "/")
+}
+
+// Menu returns the first menu with the given path, which may be given in either the export
+// or the API form, or nil if there is none.
+func (c *Config) Menu(path string) *Menu {
+	path = APIPath(path)
+	for _, m := range c.Menus {
+		if m.APIPath() == path {
+			return m
+		}
+	}
+	return nil
+}
+
+// Items returns the items of every menu with the given path, in order.
+func (c *Config) Items(path string) []*Item {
+	var items []*Item
+	path = APIPath(path)
+	for _, m := range c.Menus {
+		if m.APIPath() == path {
+			items = append(items, m.Items...)
+		}
+	}
+	return items
+}
+
+// Get returns the value of the property key and whether it is present.
+func (i *Item) Get(key string) (string, bool) {
+	for _, p := range i.Props {
+		if p.Key == key {
+			return p.Value, true
+		}
+	}
+	return "", false
+}
+
+// Set sets the property key to value, adding it at the end if it is not present.
+func (i *Item) Set(key, value string) {
+	for n := range i.Props {
+		if i.Props[n].Key == key {
+			i.Props[n].Value = value
+			return
+		}
+	}
+	i.Props = append(i.Props, Prop{Key: key, Value: value})
+}
+
+// Map returns the properties as a map.
+func (i *Item) Map() map[string]string {
+	m := make(map[string]string, len(i.Props))
+	for _, p := range i.Props {
+		m[p.Key] = p.Value
+	}
+	return m
+}
+
+// String returns the item as a single line of script, without its comments.  A scripting
+// command is returned as written, which may span several lines.
+func (i *Item) String() string {
+	if len(i.Raw) > 0 {
+		return i.Raw
+	}
+	words := []string{i.Command}
+	if len(i.Find) > 0 {
+		words = append(words, "[ find "+i.Find+" ]")
+	}
+	for _, a := range i.Args {
+		words = append(words, Quote(a))
+	}
+	for _, p := range i.Props {
+		words = append(words, p.Key+"="+Quote(p.Value))
+	}
+	return strings.Join(words, " ")
+}
+
+// WriteTo writes the configuration as script text which RouterOS can import.
+func (c *Config) WriteTo(w io.Writer) (int64, error) {
+	b := &bytes.Buffer{}
+	writeComments(b, c.Header)
+	for _, m := range c.Menus {
+		writeComments(b, m.Comments)
+		b.WriteString(m.Path + "\n")
+		for _, item := range m.Items {
+			writeComments(b, item.Comments)
+			b.WriteString(item.String() + "\n")
+		}
+	}
+	return b.WriteTo(w)
+}
+
+// String returns the configuration as script text.
+func (c *Config) String() string {
+	b := &bytes.Buffer{}
+	_, _ = c.WriteTo(b)
+	return b.String()
+}
+
+func writeComments(b *bytes.Buffer, comments []string) {
+	for _, s := range comments {
+		b.WriteString("#" + s + "\n")
+	}
+}
+
+// Quote returns s as it must be written in a script: unchanged if it needs no quoting,
+// otherwise enclosed in double quotes with special characters escaped.
+func Quote(s string) string {
+	if len(s) > 0 && strings.IndexFunc(s, needsQuote) < 0 {
+		return s
+	}
+	b := &strings.Builder{}
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		switch ch {
+		case '"', '\\', '$', '?':
+			b.WriteByte('\\')
+			b.WriteByte(ch)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if ch < 0x20 || ch == 0x7f {
+				b.WriteByte('\\')
+				b.WriteString(strings.ToUpper(hexByte(ch)))
+			} else {
+				b.WriteByte(ch)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+func needsQuote(r rune) bool {
+	switch {
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		return false
+	}
+	return !strings.ContainsRune("-_.,:/*+@!%&<>|^~", r)
+}
+
+func hexByte(b byte) string {
+	const digits = "0123456789abcdef"
+	return string([]byte{digits[b>>4], digits[b&0xf]})
+}
@@ -0,0 +1,152 @@
+package rsc
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const export = `# 2024-01-02 10:00:00 by RouterOS 7.12
+# software id = ABCD-1234
+#
+/interface ethernet
+set [ find default-name=ether1 ] comment=WAN
+/interface bridge
+add name=bridge1
+/ip firewall filter
+add action=accept chain=input comment="allow established" \
+    connection-state=established,related
+# drop everything else
+add action=drop chain=input comment="say \"hi\"\_\$x" in-interface=ether1
+/system script
+add name=test source=":log info \"one\"\r\
+    \n:log info two"
+/system identity set name=router1
+`
+
+func TestParse(t *testing.T) {
+	c, err := ParseString(export)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Header) != 3 || c.Header[1] != " software id = ABCD-1234" {
+		t.Errorf("Header=%q", c.Header)
+	}
+	if len(c.Menus) != 5 {
+		t.Fatalf("%d menus; want 5", len(c.Menus))
+	}
+
+	eth := c.Menu("/interface/ethernet").Items[0]
+	if eth.Command != "set" || eth.Find != "default-name=ether1" || eth.Map()["comment"] != "WAN" {
+		t.Errorf("ethernet item %+v", eth)
+	}
+
+	filter := c.Items("/ip firewall filter")
+	if len(filter) != 2 {
+		t.Fatalf("%d filter rules; want 2", len(filter))
+	}
+	want := []Prop{
+		{"action", "accept"},
+		{"chain", "input"},
+		{"comment", "allow established"},
+		{"connection-state", "established,related"},
+	}
+	if !reflect.DeepEqual(filter[0].Props, want) {
+		t.Errorf("Props=%q; want %q", filter[0].Props, want)
+	}
+	if filter[0].Line != 9 {
+		t.Errorf("Line=%d; want 9", filter[0].Line)
+	}
+	if v, _ := filter[1].Get("comment"); v != `say "hi" $x` {
+		t.Errorf("comment=%q", v)
+	}
+	if len(filter[1].Comments) != 1 || filter[1].Comments[0] != " drop everything else" {
+		t.Errorf("Comments=%q", filter[1].Comments)
+	}
+
+	if v, _ := c.Menu("/system script").Items[0].Get("source"); v != ":log info \"one\"\r\n:log info two" {
+		t.Errorf("source=%q", v)
+	}
+	identity := c.Menu("/system identity")
+	if identity.Path != "/system identity" || identity.Items[0].Command != "set" {
+		t.Errorf("identity menu %+v", identity)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	c, err := ParseString(export)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := c.String()
+	if !strings.Contains(out, `add action=drop chain=input comment="say \"hi\" \$x" in-interface=ether1`) {
+		t.Errorf("serialized as:\n%s", out)
+	}
+	again, err := ParseString(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.String() != out {
+		t.Fatalf("second round trip differs:\n%s\n%s", out, again.String())
+	}
+	for i := range c.Menus {
+		for j := range c.Menus[i].Items {
+			if !reflect.DeepEqual(c.Menus[i].Items[j].Props, again.Menus[i].Items[j].Props) {
+				t.Errorf("%s item %d changed", c.Menus[i].Path, j)
+			}
+		}
+	}
+}
+
+func TestRoundTripScripting(t *testing.T) {
+	script := `/ip firewall address-list
+:do { add address=10.0.0.1 list=admins } on-error={}
+:if ([:len [find list=blocked]] = 0) do={
+    add address=192.0.2.1 list=blocked comment="a } in a string"
+}
+add address=10.0.0.2 list=admins
+`
+	c, err := ParseString(script)
+	if err != nil {
+		t.Fatal(err)
+	}
+	items := c.Items("/ip/firewall/address-list")
+	if len(items) != 3 || items[0].Raw != ":do { add address=10.0.0.1 list=admins } on-error={}" ||
+		items[1].Line != 3 || items[2].Command != "add" {
+		t.Fatalf("parsed as %q", items)
+	}
+	if out := c.String(); out != script {
+		t.Fatalf("serialized as:\n%s\nwant:\n%s", out, script)
+	}
+	if _, err = ParseString("/system script\n:if (true) do={\n:log info x\n"); err == nil {
+		t.Fatal("ParseString() of an unclosed block succeeded; want error")
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, s := range []string{
+		"add name=x\n",
+		"/ip address\nadd comment=\"open\n",
+		"/interface\nset [ find name=x comment=y\n",
+	} {
+		if _, err := ParseString(s); err == nil {
+			t.Errorf("ParseString(%q) succeeded; want error", s)
+		}
+	}
+}
+
+func TestQuote(t *testing.T) {
+	tests := map[string]string{
+		"ether1":      "ether1",
+		"":            `""`,
+		"a b":         `"a b"`,
+		"10.0.0.0/24": "10.0.0.0/24",
+		"x\x01":       `"x\01"`,
+		"$var":        `"\$var"`,
+	}
+	for in, want := range tests {
+		if got := Quote(in); got != want {
+			t.Errorf("Quote(%q)=%s; want %s", in, got, want)
+		}
+	}
+}
@@ -301,40 +301,6 @@ func (c *Client) RuleIsDisabled(in interface{}) (bool, error) {
 	}
 }
 
-/*
-// ResetConnections resets all or some connections in the connection table
-func (c *Client) ResetConnections(filter string, argument string) error {
-	// get all connections
-	detail, err := c.Run("/ip/firewall/connection/print", "=.proplist=.id,src-address")
-	if err != nil {
-		log.Print(err)
-	}
-
-	// parse argument network
-	_, addressNet, err := net.ParseCIDR(argument)
-	if err != nil {
-		log.Print(err)
-	}
-
-	// iterate through routes and match against argument
-	for i := range detail.Re {
-		// separate address from port
-		src := strings.Split(detail.Re[i].Map["src-address"], ":")
-
-		// remove route if src falls in argument network
-		if addressNet.Contains(net.ParseIP(src[0])) {
-			_, err := c.Run("/ip/firewall/connection/remove", "=.id="+detail.Re[i].Map[".id"])
-			if err != nil {
-				log.Print(err)
-			}
-		}
-	}
-
-	// return nil if all good
-	return nil
-}
-*/
-
 func (c *Client) AddObject(in interface{}) error {
 	location := ""
 	s := reflect.ValueOf(in).Elem()
@@ -399,6 +365,10 @@ func GenerateTikSentence(selector string, operator string, includePosition bool,
 				if d != 0 {
 					sentence = append(sentence, operator+tag+"="+d.Round(time.Second).String())
 				}
+			case "PortSet":
+				if ports := input.(PortSet); !ports.IsZero() {
+					sentence = append(sentence, operator+tag+"="+ports.String())
+				}
 			case "time.Time":
 				tm := input.(time.Time).Format("Jan/02/2006 15:04:05")
 				sentence = append(sentence, operator+tag+"="+tm)
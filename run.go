@@ -1,10 +1,6 @@
 package gotik
 
-import (
-	"fmt"
-
-	"github.com/jjcinaz/gotik/proto"
-)
+import "fmt"
 
 type asyncReply struct {
 	chanReply
@@ -23,6 +19,16 @@ func (c *Client) Run(sentence ...string) (*Reply, error) {
 
 // RunArgs sends a sentence to the RouterOS device and waits for the reply.
 func (c *Client) RunArgs(sentence []string) (*Reply, error) {
+	if r, handled, err := c.intercept(sentence); handled {
+		return r, err
+	}
+	r, err := c.runArgs(sentence)
+	c.afterCommand(sentence, r, err)
+	return r, err
+}
+
+// runArgs sends a sentence to the device and waits for the reply without interception.
+func (c *Client) runArgs(sentence []string) (*Reply, error) {
 	c.w.BeginSentence()
 	for _, word := range sentence {
 		c.w.WriteWord(word)
@@ -30,7 +36,7 @@ func (c *Client) RunArgs(sentence []string) (*Reply, error) {
 	if !c.async {
 		return c.endCommandSync()
 	}
-	a, err := c.endCommandAsync()
+	a, err := c.endCommandAsync(sentence)
 	if err != nil {
 		return nil, err
 	}
@@ -47,10 +53,23 @@ func (c *Client) endCommandSync() (*Reply, error) {
 	return c.readReply()
 }
 
-func (c *Client) endCommandAsync() (*asyncReply, error) {
+// sendAsync writes sentence as a tagged command and registers it with the async loop.
+// The caller must wait for the returned reply's channel to be closed.
+func (c *Client) sendAsync(sentence []string) (*asyncReply, error) {
+	c.w.BeginSentence()
+	for _, word := range sentence {
+		c.w.WriteWord(word)
+	}
+	return c.endCommandAsync(sentence)
+}
+
+func (c *Client) endCommandAsync(sentence []string) (*asyncReply, error) {
 	c.nextTag++
 	a := &asyncReply{}
-	a.reC = make(chan *proto.Sentence)
+	if len(sentence) > 0 {
+		a.cmd = sentence[0]
+	}
+	a.init(0)
 	a.tag = fmt.Sprintf("r%d", c.nextTag)
 	c.w.WriteWord(".tag=" + a.tag)
 
@@ -0,0 +1,61 @@
+package gotik_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jjcinaz/gotik"
+)
+
+func TestShutdown(t *testing.T) {
+	c, s := newPair(t)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		defer s.Close()
+		s.readSentence(t, "/tool/torch @l1 [{`interface` `ether1`}]")
+		s.readSentence(t, "/cancel @r2 [{`tag` `l1`}]")
+		s.writeSentence(t, "!trap", ".tag=l1", "=category=2", "=message=interrupted")
+		s.writeSentence(t, "!done", ".tag=l1")
+		s.writeSentence(t, "!done", ".tag=r2")
+		s.readSentence(t, "/quit @ []")
+	}()
+
+	if _, err := c.Listen("/tool/torch", "=interface=ether1"); err != nil {
+		t.Fatal(err)
+	}
+	c.QuitOnShutdown = true
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := c.Shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+	<-done
+}
+
+func TestShutdownTimeout(t *testing.T) {
+	c, s := newPair(t)
+	defer s.Close()
+
+	go func() {
+		s.readSentence(t, "/tool/torch @l1 [{`interface` `ether1`}]")
+		s.readSentence(t, "/cancel @r2 [{`tag` `l1`}]")
+	}()
+
+	if _, err := c.Listen("/tool/torch", "=interface=ether1"); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := c.Shutdown(ctx)
+	var shutdownErr *gotik.ShutdownError
+	if !errors.As(err, &shutdownErr) {
+		t.Fatalf("Shutdown()=%v; want *ShutdownError", err)
+	}
+	if len(shutdownErr.Unfinished) != 1 || shutdownErr.Unfinished[0] != "l1 /tool/torch" {
+		t.Fatalf("Unfinished=%q; want [l1 /tool/torch]", shutdownErr.Unfinished)
+	}
+}
@@ -0,0 +1,363 @@
+package gotik
+
+import (
+	"net"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// Packet is a synthetic packet for Simulator.  An empty OutInterface means the packet is for the
+// router itself (the input chain), an empty InInterface means the router sends it (the output
+// chain); with both set, the packet is forwarded.
+type Packet struct {
+	InInterface     string
+	OutInterface    string
+	SrcAddress      string
+	DstAddress      string
+	Protocol        string // tcp, udp, icmp...
+	SrcPort         int
+	DstPort         int
+	ConnectionState string // new, established, related, invalid or untracked
+}
+
+// SimStep is a rule which matched the packet on its way through a chain.
+type SimStep struct {
+	Chain  string
+	Rule   int // index of the rule in its table
+	Action string
+}
+
+// SimResult is the outcome of sending a packet through one chain.
+type SimResult struct {
+	Table   string // filter or nat
+	Chain   string // the chain the packet was sent to
+	Action  string // the deciding action, "accept" when the chain ran out, or "unknown"
+	Rule    int    // index of the deciding rule, -1 when the chain ran out
+	Unknown []string
+	Trace   []SimStep
+}
+
+// PathResult is the outcome of Simulator.Path.
+type PathResult struct {
+	Action  string      // the action of the filter, or "unknown"
+	Packet  Packet      // the packet after address translation
+	Results []SimResult // each chain the packet was sent to, in order
+}
+
+// Simulator evaluates IPv4 filter and NAT rules against synthetic packets without talking to the
+// router.  Only the rule properties below are understood; a rule using any other matcher, or an
+// in-interface-list when InterfaceLists is nil, makes the result "unknown" with the matchers
+// listed, unless one of the understood matchers already rules the packet out.
+//
+//	chain protocol src-address dst-address src-port dst-port port in-interface out-interface
+//	in-interface-list out-interface-list src-address-list dst-address-list connection-state
+//
+// Jump and return work as on the router, and add-src-to-address-list and add-dst-to-address-list
+// rules add to the address lists for the rest of the evaluation.
+type Simulator struct {
+	Filter         []IPv4FilterRule
+	Nat            []IPv4NatRule
+	AddressLists   []AddressList
+	InterfaceLists map[string][]string // members of each interface list
+}
+
+// simRule is a rule of either table reduced to what the simulator needs.
+type simRule struct {
+	index       int
+	chain       string
+	action      string
+	jumpTarget  string
+	addressList string
+	toAddresses string
+	toPorts     string
+	disabled    bool
+	matchers    [][2]string // tag and value, in field order
+}
+
+// simNonMatchers are the properties of NAT rules which are not matchers, besides nonMatchers.
+var simNonMatchers = map[string]bool{"to-addresses": true, "to-ports": true}
+
+// natActions are the NAT actions which end the chain.
+var natActions = map[string]bool{
+	"accept": true, "dst-nat": true, "src-nat": true, "masquerade": true, "redirect": true,
+	"netmap": true, "same": true, "endpoint-independent-nat": true,
+}
+
+// maxJumpDepth bounds nested jumps so that a jump loop ends.
+const maxJumpDepth = 32
+
+func simRulesOf[T any](rules []T) []simRule {
+	out := make([]simRule, 0, len(rules))
+	for i := range rules {
+		v := reflect.ValueOf(&rules[i]).Elem()
+		t := v.Type()
+		r := simRule{index: i}
+		for f := 0; f < v.NumField(); f++ {
+			tag := t.Field(f).Tag.Get("tik")
+			if len(tag) == 0 || t.Field(f).Name == "RouterLocation" {
+				continue
+			}
+			value, managed := formatTikValue(v.Field(f))
+			switch tag {
+			case "chain":
+				r.chain = value
+			case "action":
+				r.action = value
+			case "jump-target":
+				r.jumpTarget = value
+			case "address-list":
+				r.addressList = value
+			case "to-addresses":
+				r.toAddresses = value
+			case "to-ports":
+				r.toPorts = value
+			case "disabled":
+				r.disabled = v.Field(f).Bool()
+			}
+			if managed && !nonMatchers[tag] && !simNonMatchers[tag] && v.Field(f).Kind() != reflect.Bool {
+				r.matchers = append(r.matchers, [2]string{tag, value})
+			}
+		}
+		if len(r.action) == 0 {
+			r.action = "accept"
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+// simRun holds the state of one evaluation.
+type simRun struct {
+	s     *Simulator
+	added map[string][]string // addresses added to address lists by the rules
+}
+
+// Run sends p through chain of table, which is "filter" or "nat", and returns the outcome.
+// A NAT action changes p accordingly.
+func (s *Simulator) Run(table, chain string, p *Packet) SimResult {
+	run := &simRun{s: s, added: make(map[string][]string)}
+	return run.walk(table, chain, p)
+}
+
+// Path sends p through the router: the dstnat chain for a new connection coming in, the input,
+// forward or output filter chain, then for an accepted new connection going out, the srcnat
+// chain.  NAT chains are only evaluated for new connections, since the router translates the
+// other packets as it did the first one.  The evaluation stops at the first unknown result.
+func (s *Simulator) Path(p Packet) PathResult {
+	run := &simRun{s: s, added: make(map[string][]string)}
+	res := PathResult{Action: "unknown"}
+	isNew := p.ConnectionState == "new"
+	chain := "forward"
+	switch {
+	case len(p.OutInterface) == 0:
+		chain = "input"
+	case len(p.InInterface) == 0:
+		chain = "output"
+	}
+	if isNew && chain != "output" {
+		r := run.walk("nat", "dstnat", &p)
+		res.Results = append(res.Results, r)
+		if r.Action == "unknown" {
+			res.Packet = p
+			return res
+		}
+	}
+	r := run.walk("filter", chain, &p)
+	res.Results = append(res.Results, r)
+	if isNew && chain != "input" && r.Action == "accept" {
+		n := run.walk("nat", "srcnat", &p)
+		res.Results = append(res.Results, n)
+		if n.Action == "unknown" {
+			res.Packet = p
+			return res
+		}
+	}
+	res.Action = r.Action
+	res.Packet = p
+	return res
+}
+
+func (run *simRun) walk(table, chain string, p *Packet) SimResult {
+	var rules []simRule
+	if table == "nat" {
+		rules = simRulesOf(run.s.Nat)
+	} else {
+		rules = simRulesOf(run.s.Filter)
+	}
+	chains := make(map[string][]simRule)
+	for _, r := range rules {
+		chains[r.chain] = append(chains[r.chain], r)
+	}
+
+	res := SimResult{Table: table, Chain: chain, Action: "accept", Rule: -1}
+	type frame struct {
+		chain string
+		pos   int
+	}
+	var stack []frame
+	cur, pos := chain, 0
+	for {
+		if pos >= len(chains[cur]) {
+			if len(stack) == 0 {
+				return res
+			}
+			cur, pos = stack[len(stack)-1].chain, stack[len(stack)-1].pos
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		r := chains[cur][pos]
+		pos++
+		if r.disabled {
+			continue
+		}
+		matched, unknown := run.match(&r, p)
+		if !matched {
+			continue
+		}
+		if len(unknown) > 0 {
+			res.Action, res.Rule, res.Unknown = "unknown", r.index, unknown
+			return res
+		}
+		res.Trace = append(res.Trace, SimStep{Chain: cur, Rule: r.index, Action: r.action})
+		switch {
+		case r.action == "jump":
+			if len(stack) >= maxJumpDepth {
+				res.Action, res.Rule, res.Unknown = "unknown", r.index, []string{"jump-target=" + r.jumpTarget}
+				return res
+			}
+			stack = append(stack, frame{cur, pos})
+			cur, pos = r.jumpTarget, 0
+		case r.action == "return":
+			pos = len(chains[cur])
+		case r.action == "passthrough", r.action == "log", r.action == "fasttrack-connection":
+		case r.action == "add-src-to-address-list":
+			run.added[r.addressList] = append(run.added[r.addressList], p.SrcAddress)
+		case r.action == "add-dst-to-address-list":
+			run.added[r.addressList] = append(run.added[r.addressList], p.DstAddress)
+		case table == "filter" && terminalActions[r.action], table == "nat" && natActions[r.action]:
+			res.Action, res.Rule = r.action, r.index
+			if table == "nat" {
+				translate(&r, p)
+			}
+			return res
+		default:
+			res.Action, res.Rule, res.Unknown = "unknown", r.index, []string{"action=" + r.action}
+			return res
+		}
+	}
+}
+
+// translate applies the address and port changes of a NAT rule which can be known offline.
+func translate(r *simRule, p *Packet) {
+	addr := r.toAddresses
+	if lo, _, ok := ipv4Span(addr); ok {
+		addr = net.IPv4(byte(lo>>24), byte(lo>>16), byte(lo>>8), byte(lo)).String()
+	}
+	toPorts, _ := ParsePortSet(r.toPorts)
+	port := toPorts.First()
+	switch r.action {
+	case "dst-nat":
+		if len(addr) > 0 {
+			p.DstAddress = addr
+		}
+		if port > 0 {
+			p.DstPort = port
+		}
+	case "redirect":
+		if port > 0 {
+			p.DstPort = port
+		}
+	case "src-nat":
+		if len(addr) > 0 {
+			p.SrcAddress = addr
+		}
+		if port > 0 {
+			p.SrcPort = port
+		}
+	case "masquerade":
+		if port > 0 {
+			p.SrcPort = port
+		}
+	}
+}
+
+// match returns false if any understood matcher of r rules p out.  Otherwise it returns true
+// along with the matchers which could not be evaluated.
+func (run *simRun) match(r *simRule, p *Packet) (bool, []string) {
+	var unknown []string
+	for _, m := range r.matchers {
+		tag, value := m[0], m[1]
+		negate := strings.HasPrefix(value, "!")
+		value = strings.TrimPrefix(value, "!")
+		var ok bool
+		switch tag {
+		case "protocol":
+			ok = p.Protocol == value
+		case "src-address":
+			ok = ipv4SpanContains(value, p.SrcAddress)
+		case "dst-address":
+			ok = ipv4SpanContains(value, p.DstAddress)
+		case "src-port":
+			ok = portSetContains(value, p.SrcPort)
+		case "dst-port":
+			ok = portSetContains(value, p.DstPort)
+		case "port":
+			ok = portSetContains(value, p.SrcPort) || portSetContains(value, p.DstPort)
+		case "in-interface":
+			ok = p.InInterface == value
+		case "out-interface":
+			ok = p.OutInterface == value
+		case "in-interface-list", "out-interface-list":
+			intf := p.InInterface
+			if tag == "out-interface-list" {
+				intf = p.OutInterface
+			}
+			if value == "all" {
+				ok = len(intf) > 0
+			} else if run.s.InterfaceLists == nil {
+				unknown = append(unknown, m[0]+"="+m[1])
+				continue
+			} else {
+				ok = slices.Contains(run.s.InterfaceLists[value], intf)
+			}
+		case "src-address-list":
+			ok = run.inAddressList(value, p.SrcAddress)
+		case "dst-address-list":
+			ok = run.inAddressList(value, p.DstAddress)
+		case "connection-state":
+			if len(p.ConnectionState) == 0 {
+				unknown = append(unknown, m[0]+"="+m[1])
+				continue
+			}
+			ok = setContains(value, p.ConnectionState)
+		default:
+			unknown = append(unknown, m[0]+"="+m[1])
+			continue
+		}
+		if ok == negate {
+			return false, nil
+		}
+	}
+	return true, unknown
+}
+
+// portSetContains returns true if the port set value holds port, which must be set.
+func portSetContains(value string, port int) bool {
+	ports, err := ParsePortSet(value)
+	return err == nil && port > 0 && !ports.IsZero() && ports.Contains(port)
+}
+
+func (run *simRun) inAddressList(list, addr string) bool {
+	for _, entry := range run.s.AddressLists {
+		if entry.List == list && !entry.Disabled && ipv4SpanContains(entry.Address, addr) {
+			return true
+		}
+	}
+	for _, a := range run.added[list] {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+package gotik_test
+
+import (
+	"testing"
+
+	"github.com/jjcinaz/gotik"
+)
+
+func TestSimulatorPath(t *testing.T) {
+	sim := gotik.Simulator{
+		Filter: []gotik.IPv4FilterRule{
+			{Chain: "forward", Action: "accept", ConnectionState: "established,related"},
+			{Chain: "forward", Action: "jump", JumpTarget: "wan-in", InInterface: "ether1"},
+			{Chain: "forward", Action: "drop", InInterface: "ether1"},
+			{Chain: "wan-in", Action: "accept", Protocol: "tcp", DstAddress: "192.168.88.10", DstPort: gotik.Ports(80, 443)},
+			{Chain: "wan-in", Action: "drop", SrcAddressList: "blocked"},
+			{Chain: "wan-in", Action: "return"},
+			{Chain: "input", Action: "accept", SrcAddressList: "admins", DstPort: gotik.Ports(22), Protocol: "tcp"},
+			{Chain: "input", Action: "drop", Limit: "10,5:packet"},
+			{Chain: "input", Action: "drop"},
+		},
+		Nat: []gotik.IPv4NatRule{
+			{Chain: "dstnat", Action: "dst-nat", InInterface: "ether1", Protocol: "tcp", DstPort: gotik.Ports(8443),
+				ToAddresses: "192.168.88.10", ToPorts: gotik.Ports(443)},
+			{Chain: "srcnat", Action: "masquerade", OutInterface: "ether1"},
+		},
+		AddressLists: []gotik.AddressList{
+			{List: "admins", Address: "10.0.0.0/24"},
+			{List: "blocked", Address: "198.51.100.0/24"},
+		},
+	}
+
+	// port forward: translated by dstnat, then accepted in wan-in
+	res := sim.Path(gotik.Packet{InInterface: "ether1", OutInterface: "bridge", SrcAddress: "203.0.113.5",
+		DstAddress: "203.0.113.1", Protocol: "tcp", SrcPort: 50000, DstPort: 8443, ConnectionState: "new"})
+	if res.Action != "accept" || res.Packet.DstAddress != "192.168.88.10" || res.Packet.DstPort != 443 {
+		t.Fatalf("port forward: got %s to %s:%d", res.Action, res.Packet.DstAddress, res.Packet.DstPort)
+	}
+	if f := res.Results[1]; f.Rule != 3 || len(f.Trace) != 2 {
+		t.Fatalf("port forward: decided by rule %d with trace %v", f.Rule, f.Trace)
+	}
+
+	// the jump returns and the packet is dropped by the rule after the jump
+	res = sim.Path(gotik.Packet{InInterface: "ether1", OutInterface: "bridge", SrcAddress: "203.0.113.5",
+		DstAddress: "192.168.88.20", Protocol: "udp", SrcPort: 50000, DstPort: 53, ConnectionState: "new"})
+	if res.Action != "drop" || res.Results[1].Rule != 2 {
+		t.Fatalf("return: got %s by rule %d", res.Action, res.Results[1].Rule)
+	}
+
+	// admins reach ssh
+	res = sim.Path(gotik.Packet{InInterface: "bridge", SrcAddress: "10.0.0.5", DstAddress: "10.0.0.1",
+		Protocol: "tcp", SrcPort: 50000, DstPort: 22, ConnectionState: "new"})
+	if res.Action != "accept" {
+		t.Fatalf("ssh: got %s", res.Action)
+	}
+
+	// the limit matcher is not understood, so the result is unknown rather than a guess
+	res = sim.Path(gotik.Packet{InInterface: "bridge", SrcAddress: "10.0.1.5", DstAddress: "10.0.0.1",
+		Protocol: "tcp", SrcPort: 50000, DstPort: 22, ConnectionState: "new"})
+	if res.Action != "unknown" || len(res.Results[1].Unknown) != 1 || res.Results[1].Unknown[0] != "limit=10,5:packet" {
+		t.Fatalf("limit: got %s, unknown %v", res.Action, res.Results[len(res.Results)-1].Unknown)
+	}
+}
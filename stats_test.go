@@ -0,0 +1,72 @@
+package gotik_test
+
+import (
+	"bufio"
+	"context"
+	"testing"
+	"time"
+)
+
+func TestResetFilterCounters(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+	r := bufio.NewReader(s.Closer.(*conn).PipeReader)
+
+	go func() {
+		defer s.Close()
+		readWords(t, r, "/ip/firewall/filter/print =.proplist=.id ?=chain=input")
+		s.writeSentence(t, "!re", "=.id=*1")
+		s.writeSentence(t, "!re", "=.id=*2")
+		s.writeSentence(t, "!done")
+		readWords(t, r, "/ip/firewall/filter/reset-counters =numbers=*1,*2")
+		s.writeSentence(t, "!done")
+	}()
+
+	if err := c.ResetFilterCounters("input"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSampleFilterRates(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+	r := bufio.NewReader(s.Closer.(*conn).PipeReader)
+
+	go func() {
+		defer s.Close()
+		readWords(t, r, "/ip/firewall/filter/print")
+		s.writeSentence(t, "!re", "=.id=*1", "=chain=input", "=action=accept", "=bytes=1000", "=packets=10")
+		s.writeSentence(t, "!re", "=.id=*2", "=chain=input", "=action=drop", "=bytes=500", "=packets=5")
+		s.writeSentence(t, "!done")
+		readWords(t, r, "/ip/firewall/filter/print")
+		s.writeSentence(t, "!re", "=.id=*1", "=chain=input", "=action=accept", "=bytes=3000", "=packets=30")
+		s.writeSentence(t, "!re", "=.id=*2", "=chain=input", "=action=drop", "=bytes=100", "=packets=1")
+		s.writeSentence(t, "!re", "=.id=*3", "=chain=input", "=action=drop", "=bytes=0", "=packets=0")
+		s.writeSentence(t, "!done")
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch, err := c.SampleFilterRates(ctx, "", 10*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sample := <-ch
+	cancel()
+	if sample.Err != nil {
+		t.Fatal(sample.Err)
+	}
+	if len(sample.Rates) != 3 {
+		t.Fatalf("got %d rates; want 3", len(sample.Rates))
+	}
+	want := []struct{ bytes, packets int64 }{{2000, 20}, {100, 1}, {0, 0}}
+	for i, w := range want {
+		got := sample.Rates[i]
+		if got.Bytes != w.bytes || got.Packets != w.packets {
+			t.Errorf("rule %s: got %d bytes %d packets; want %d, %d", got.Rule.ID, got.Bytes, got.Packets, w.bytes, w.packets)
+		}
+	}
+	if sample.Rates[0].BytesPerSec <= 0 {
+		t.Errorf("BytesPerSec = %v; want > 0", sample.Rates[0].BytesPerSec)
+	}
+}
@@ -0,0 +1,231 @@
+package gotik_test
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/jjcinaz/gotik"
+)
+
+func TestExportConfigToWriter(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	first := strings.Repeat("a", gotik.DefaultFileChunkSize)
+
+	go func() {
+		s := acceptRouter(t, ln)
+		defer s.Close()
+		sen := s.expectCommand(t, "/ip/firewall/export")
+		s.writeSentence(t, "!done")
+		export := sen.Map["file"] + ".rsc"
+		s.readSentence(t, "/file/print @ []")
+		s.writeSentence(t, "!re", "=.id=*1", "=name=other.rsc")
+		s.writeSentence(t, "!re", "=.id=*2", "=name="+export)
+		s.writeSentence(t, "!done")
+		readWords(t, bufio.NewReader(s.Closer.(net.Conn)), "/file/print ?name="+export+" =.proplist=size")
+		s.writeSentence(t, "!re", "=size=32788")
+		s.writeSentence(t, "!done")
+		s.readSentence(t, "/file/read @ [{`file` `"+export+"`} {`offset` `0`} {`chunk-size` `32768`}]")
+		s.writeSentence(t, "!re", "=data="+first)
+		s.writeSentence(t, "!done")
+		s.readSentence(t, "/file/read @ [{`file` `"+export+"`} {`offset` `32768`} {`chunk-size` `32768`}]")
+		s.writeSentence(t, "!re", "=data=/ip firewall filter\n")
+		s.writeSentence(t, "!done")
+		s.readSentence(t, "/file/remove @ [{`.id` `*2`}]")
+		s.writeSentence(t, "!done")
+	}()
+
+	c, err := gotik.Dial(ln.Addr().String(), "admin", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	buf := &bytes.Buffer{}
+	if err = c.ExportConfigToWriter("/ip/firewall", buf, true); err != nil {
+		t.Fatal(err)
+	}
+	if want := first + "/ip firewall filter\n"; buf.String() != want {
+		t.Fatalf("export of %d bytes; want %d", buf.Len(), len(want))
+	}
+}
+
+func TestDownloadFileTruncated(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	go func() {
+		s := acceptRouter(t, ln)
+		defer s.Close()
+		readWords(t, bufio.NewReader(s.Closer.(net.Conn)), "/file/print ?name=big.rsc =.proplist=size")
+		s.writeSentence(t, "!re", "=size=40000")
+		s.writeSentence(t, "!done")
+		s.readSentence(t, "/file/read @ [{`file` `big.rsc`} {`offset` `0`} {`chunk-size` `32768`}]")
+		s.writeSentence(t, "!re", "=data=/ip firewall filter\n")
+		s.writeSentence(t, "!done")
+		s.readSentence(t, "/file/read @ [{`file` `big.rsc`} {`offset` `20`} {`chunk-size` `32768`}]")
+		s.writeSentence(t, "!re", "=data=")
+		s.writeSentence(t, "!done")
+	}()
+
+	c, err := gotik.Dial(ln.Addr().String(), "admin", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if _, err = c.ReadFile("big.rsc"); !errors.Is(err, gotik.ErrFileTruncated) {
+		t.Fatalf("ReadFile()=%v; want %v", err, gotik.ErrFileTruncated)
+	}
+}
+
+func TestDownloadFileTruncatedPrint(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+	r := bufio.NewReader(s.Closer.(*conn).PipeReader)
+
+	go func() {
+		defer s.Close()
+		readWords(t, r, "/file/print ?name=export.rsc =.proplist=size,contents")
+		s.writeSentence(t, "!re", "=size=5000", "=contents=/ip firewall filter\n")
+		s.writeSentence(t, "!done")
+		readWords(t, r, "/file/print ?name=small.rsc =.proplist=size,contents")
+		s.writeSentence(t, "!re", "=size=20", "=contents=/ip firewall filter\n")
+		s.writeSentence(t, "!done")
+	}()
+
+	buf := &bytes.Buffer{}
+	if err := c.DownloadFile("export.rsc", buf); !errors.Is(err, gotik.ErrFileTruncated) {
+		t.Fatalf("DownloadFile()=%v; want %v", err, gotik.ErrFileTruncated)
+	}
+	data, err := c.ReadFile("small.rsc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "/ip firewall filter\n" {
+		t.Fatalf("ReadFile()=%q", data)
+	}
+}
+
+func TestUploadFile(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	contents := strings.Repeat("b", 2*gotik.DefaultUploadChunkSize) + "end"
+
+	go func() {
+		s := acceptRouter(t, ln)
+		defer s.Close()
+		sen := s.expectCommand(t, "/file/add")
+		if sen.Map["name"] != "cert.pem" || len(sen.Map["contents"]) != gotik.DefaultUploadChunkSize {
+			t.Errorf("first chunk %s", sen.Map["name"])
+		}
+		s.writeSentence(t, "!done")
+		sen = s.expectCommand(t, "/file/add")
+		if sen.Map["name"] != "cert.pem.part1" || len(sen.Map["contents"]) != gotik.DefaultUploadChunkSize {
+			t.Errorf("second chunk %s", sen.Map["name"])
+		}
+		s.writeSentence(t, "!done")
+		s.readSentence(t, "/file/add @ [{`name` `cert.pem.part2`} {`type` `file`} {`contents` `end`}]")
+		s.writeSentence(t, "!done")
+		sen = s.expectCommand(t, "/system/script/add")
+		if !strings.Contains(sen.Map["source"], `in={"cert.pem.part1";"cert.pem.part2"}`) {
+			t.Errorf("script does not append the part: %s", sen.Map["source"])
+		}
+		s.writeSentence(t, "!done", "=ret=*7")
+		s.readSentence(t, "/system/script/run @ [{`number` `*7`}]")
+		s.writeSentence(t, "!done")
+		s.readSentence(t, "/system/script/remove @ [{`.id` `*7`}]")
+		s.writeSentence(t, "!done")
+		s.readSentence(t, "/file/print @ []")
+		s.writeSentence(t, "!re", "=.id=*3", "=name=cert.pem", "=size=32771")
+		s.writeSentence(t, "!done")
+	}()
+
+	c, err := gotik.Dial(ln.Addr().String(), "admin", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if err = c.UploadFile("cert.pem", strings.NewReader(contents)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUploadFileCleanup(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	contents := strings.Repeat("b", 2*gotik.DefaultUploadChunkSize) + "end"
+
+	go func() {
+		s := acceptRouter(t, ln)
+		defer s.Close()
+		s.expectCommand(t, "/file/add")
+		s.writeSentence(t, "!done")
+		s.expectCommand(t, "/file/add")
+		s.writeSentence(t, "!done")
+		s.readSentence(t, "/file/add @ [{`name` `cert.pem.part2`} {`type` `file`} {`contents` `end`}]")
+		s.writeSentence(t, "!trap", "=message=failure: not enough disk space")
+		s.writeSentence(t, "!done")
+		s.readSentence(t, "/file/remove @ [{`name` `cert.pem`}]")
+		s.writeSentence(t, "!done")
+		s.readSentence(t, "/file/remove @ [{`name` `cert.pem.part1`}]")
+		s.writeSentence(t, "!done")
+	}()
+
+	c, err := gotik.Dial(ln.Addr().String(), "admin", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if err = c.UploadFile("cert.pem", strings.NewReader(contents)); err == nil {
+		t.Fatal("UploadFile() succeeded with a failed part; want error")
+	}
+	// the removals must have been sent before UploadFile returned
+	if _, err = c.Run("/system/identity/print"); err == nil {
+		t.Fatal("connection still open; want the fake router to have hung up")
+	}
+}
+
+func TestUploadFileBinary(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	contents := strings.Repeat("b", gotik.DefaultUploadChunkSize) + "\x00\x01npk"
+
+	go func() {
+		s := acceptRouter(t, ln)
+		defer s.Close()
+		s.expectCommand(t, "/file/add")
+		s.writeSentence(t, "!done")
+		s.readSentence(t, "/file/remove @ [{`name` `routeros.npk`}]")
+		s.writeSentence(t, "!done")
+	}()
+
+	c, err := gotik.Dial(ln.Addr().String(), "admin", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if err = c.UploadFile("routeros.npk", strings.NewReader(contents)); !errors.Is(err, gotik.ErrBinaryFile) {
+		t.Fatalf("UploadFile()=%v; want %v", err, gotik.ErrBinaryFile)
+	}
+	if err = c.UploadFile("routeros.npk", strings.NewReader("\x00")); !errors.Is(err, gotik.ErrBinaryFile) {
+		t.Fatalf("UploadFile()=%v; want %v", err, gotik.ErrBinaryFile)
+	}
+}
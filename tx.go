@@ -0,0 +1,371 @@
+package gotik
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Tx is a transactional change set.  While a Tx is active on a client, every add, set, remove,
+// enable, disable, unset and move sent through the client, whether by Tx.Run or by any of the
+// helpers such as AddQueueTree or UpdateUser, has its inverse recorded.  The prior values are
+// captured with a print before each set, remove, enable, disable, unset or move.  Rollback
+// replays the inverses in reverse order; Commit simply forgets them.
+//
+// Items which are removed and then restored by a rollback get new IDs from the device; later
+// inverses referring to the old ID are adjusted automatically.  Values the device does not
+// return from a print (passwords, for example) cannot be restored.  A remove is only accepted on
+// the menus whose settable properties are known, which are those of the helpers in this package;
+// on any other menu it fails before it is sent.
+type Tx struct {
+	c    *Client
+	mu   sync.Mutex
+	undo []txUndo
+	done bool
+}
+
+type txUndo struct {
+	sentence []string
+	oldID    string // if set, the undo re-adds the item which had this ID
+}
+
+// orderedMenus are the menus where the position of an item matters, so that a removed
+// item is restored in its original place.
+var orderedMenus = map[string]bool{
+	"/ip/firewall/filter":   true,
+	"/ip/firewall/nat":      true,
+	"/ip/firewall/mangle":   true,
+	"/ip/firewall/raw":      true,
+	"/ipv6/firewall/filter": true,
+	"/ipv6/firewall/nat":    true,
+	"/ipv6/firewall/mangle": true,
+	"/ipv6/firewall/raw":    true,
+	"/queue/simple":         true,
+}
+
+// settableProps are the properties add accepts on each menu where a transaction can undo a
+// remove.  A removed item is restored with the printed values of these properties only, so that
+// counters and state such as rate, dropped or actual-mtu are not given back to add.  The firewall
+// and address-list menus are filled in from the tik tags of their types.
+var settableProps = map[string]map[string]bool{
+	"/ip/address": propSet("address", "network", "interface", "comment", "disabled"),
+	"/ip/route": propSet("dst-address", "gateway", "distance", "scope", "target-scope", "routing-mark",
+		"routing-table", "pref-src", "check-gateway", "type", "vrf-interface", "suppress-hw-offload",
+		"comment", "disabled"),
+	"/queue/simple": propSet("name", "target", "dst", "parent", "packet-marks", "priority", "queue",
+		"limit-at", "max-limit", "burst-limit", "burst-threshold", "burst-time", "bucket-size", "time",
+		"comment", "disabled"),
+	"/queue/tree": propSet("name", "parent", "packet-mark", "priority", "queue", "limit-at", "max-limit",
+		"burst-limit", "burst-threshold", "burst-time", "bucket-size", "comment", "disabled"),
+	"/ppp/secret": propSet("name", "password", "profile", "service", "caller-id", "local-address",
+		"remote-address", "routes", "ipv6-routes", "limit-bytes-in", "limit-bytes-out", "comment", "disabled"),
+	"/interface/pppoe-server/server": propSet("service-name", "interface", "max-mtu", "max-mru", "mrru",
+		"authentication", "keepalive-timeout", "one-session-per-host", "max-sessions", "pado-delay",
+		"default-profile", "disabled"),
+	"/radius": propSet("service", "address", "secret", "authentication-port", "accounting-port",
+		"accounting-backup", "timeout", "called-id", "domain", "realm", "src-address", "protocol",
+		"certificate", "require-message-auth", "comment", "disabled"),
+	"/snmp/community": propSet("name", "addresses", "security", "read-access", "write-access",
+		"authentication-protocol", "authentication-password", "encryption-protocol", "encryption-password",
+		"comment", "disabled"),
+	"/system/script": propSet("name", "source", "owner", "policy", "dont-require-permissions", "comment"),
+	"/system/scheduler": propSet("name", "start-date", "start-time", "interval", "on-event", "policy",
+		"comment", "disabled"),
+}
+
+func init() {
+	for _, v := range []any{AddressList{}, IPv4FilterRule{}, IPv4NatRule{}, IPv4MangleRule{}, IPv4RawRule{},
+		IPv6FilterRule{}, IPv6NatRule{}, IPv6MangleRule{}, IPv6RawRule{}} {
+		t := reflect.TypeOf(v)
+		field, _ := t.FieldByName("RouterLocation")
+		props := make(map[string]bool)
+		for i := 0; i < t.NumField(); i++ {
+			tag := t.Field(i).Tag.Get("tik")
+			switch {
+			case len(tag) == 0, t.Field(i).Name == "RouterLocation":
+			case tag == ".id", tag == "dynamic", tag == "invalid", tag == "place-before":
+			default:
+				props[tag] = true
+			}
+		}
+		settableProps[field.Tag.Get("tik")] = props
+	}
+}
+
+func propSet(names ...string) map[string]bool {
+	m := make(map[string]bool, len(names))
+	for _, name := range names {
+		m[name] = true
+	}
+	return m
+}
+
+// BeginTx starts a transaction on the client.  Only one transaction may be active at a time.
+func (c *Client) BeginTx() (*Tx, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.tx != nil {
+		return nil, ErrTxActive
+	}
+	c.tx = &Tx{c: c}
+	return c.tx, nil
+}
+
+// WithTx runs fn inside a transaction.  If fn returns an error, the transaction is rolled
+// back and the error from fn is returned, joined with any error from the rollback.
+// Otherwise the transaction is committed.
+//
+//	err := c.WithTx(func() error {
+//		if _, err := c.AddVLANInterface(vlan); err != nil {
+//			return err
+//		}
+//		_, err := c.AddIPv4Address(addr)
+//		return err
+//	})
+func (c *Client) WithTx(fn func() error) error {
+	tx, err := c.BeginTx()
+	if err != nil {
+		return err
+	}
+	if err = fn(); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return errors.Join(err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// Run simply calls RunArgs() on the client of the transaction.
+func (tx *Tx) Run(sentence ...string) (*Reply, error) {
+	return tx.c.RunArgs(sentence)
+}
+
+// Commit ends the transaction, keeping all changes.
+func (tx *Tx) Commit() error {
+	if !tx.end() {
+		return ErrTxDone
+	}
+	return nil
+}
+
+// Rollback ends the transaction and undoes its changes, most recent first.  Every inverse
+// is attempted even if some fail; the errors are joined and returned.
+func (tx *Tx) Rollback() error {
+	if !tx.end() {
+		return ErrTxDone
+	}
+	var errs []error
+	newIDs := make(map[string]string)
+	for i := len(tx.undo) - 1; i >= 0; i-- {
+		u := tx.undo[i]
+		sentence := make([]string, 0, len(u.sentence))
+		for _, word := range u.sentence {
+			sentence = append(sentence, remapIDs(word, newIDs))
+		}
+		r, err := tx.c.runArgs(sentence)
+		tx.c.afterCommand(sentence, r, err)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("rollback %s: %w", sentence[0], err))
+			continue
+		}
+		if len(u.oldID) > 0 && r.Done != nil {
+			newIDs[u.oldID] = r.Done.Map["ret"]
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// end detaches the transaction from its client.  It returns false if it had already ended.
+func (tx *Tx) end() bool {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+	if tx.done {
+		return false
+	}
+	tx.done = true
+	tx.c.mu.Lock()
+	if tx.c.tx == tx {
+		tx.c.tx = nil
+	}
+	tx.c.mu.Unlock()
+	return true
+}
+
+// run sends a command which changes the device, recording its inverse.
+func (tx *Tx) run(sentence []string) (*Reply, error) {
+	path, verb := splitCommand(sentence[0])
+	args := argMap(sentence[1:])
+	ids := strings.Split(args[".id"], ",")
+	if len(args[".id"]) == 0 {
+		ids = strings.Split(args["numbers"], ",")
+	}
+
+	var (
+		undo []txUndo
+		err  error
+	)
+	switch verb {
+	case "add":
+		// the inverse needs the new ID, so it is recorded after the command
+	case "set", "unset", "comment", "enable", "disable", "remove", "move":
+		if verb == "remove" && settableProps[path] == nil {
+			return nil, fmt.Errorf("transaction cannot undo %s: the properties of %s are not known", sentence[0], path)
+		}
+		for _, id := range ids {
+			var u []txUndo
+			if u, err = tx.inverse(path, verb, id, args); err != nil {
+				return nil, err
+			}
+			undo = append(undo, u...)
+		}
+	default:
+		return nil, fmt.Errorf("transaction cannot undo %s", sentence[0])
+	}
+
+	r, err := tx.c.runArgs(sentence)
+	tx.c.afterCommand(sentence, r, err)
+	if err != nil {
+		return r, err
+	}
+	if verb == "add" && r.Done != nil && len(r.Done.Map["ret"]) > 0 {
+		undo = append(undo, txUndo{sentence: []string{path + "/remove", "=.id=" + r.Done.Map["ret"]}})
+	}
+	tx.mu.Lock()
+	tx.undo = append(tx.undo, undo...)
+	tx.mu.Unlock()
+	return r, nil
+}
+
+// inverse captures the current state of item id (or the single item of a settings menu if
+// id is empty) and returns the commands which restore it after verb is applied.
+func (tx *Tx) inverse(path, verb, id string, args map[string]string) ([]txUndo, error) {
+	cmd := []string{path + "/print"}
+	if len(id) > 0 {
+		cmd = append(cmd, "?.id="+id)
+	}
+	detail, err := tx.c.runArgs(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if len(detail.Re) != 1 {
+		if len(id) == 0 {
+			return nil, fmt.Errorf("transaction cannot undo %s/%s without an ID", path, verb)
+		}
+		return nil, ErrNotFound
+	}
+	prior := detail.Re[0].Map
+	selector := []string{}
+	if len(id) > 0 {
+		selector = append(selector, "=.id="+id)
+	}
+
+	switch verb {
+	case "set", "unset", "comment":
+		restore := append([]string{path + "/set"}, selector...)
+		keys := make([]string, 0, len(args))
+		if verb == "unset" {
+			keys = append(keys, args["value-name"])
+		} else {
+			for k := range args {
+				if k != ".id" && k != "numbers" {
+					keys = append(keys, k)
+				}
+			}
+			sort.Strings(keys)
+		}
+		// a property the print left out was never set, so the undo unsets it
+		var undo []txUndo
+		for _, k := range keys {
+			if v, found := prior[k]; found {
+				restore = append(restore, "="+k+"="+v)
+			} else if verb != "unset" {
+				unset := append([]string{path + "/unset"}, selector...)
+				undo = append(undo, txUndo{sentence: append(unset, "=value-name="+k)})
+			}
+		}
+		if len(restore) > 1+len(selector) {
+			undo = append(undo, txUndo{sentence: restore})
+		}
+		return undo, nil
+	case "enable", "disable":
+		if parseBool(prior["disabled"]) {
+			return []txUndo{{sentence: append([]string{path + "/disable"}, selector...)}}, nil
+		}
+		return []txUndo{{sentence: append([]string{path + "/enable"}, selector...)}}, nil
+	case "remove":
+		restore := []string{path + "/add"}
+		for _, p := range detail.Re[0].List {
+			if settableProps[path][p.Key] {
+				restore = append(restore, "="+p.Key+"="+p.Value)
+			}
+		}
+		if orderedMenus[path] {
+			next, err := tx.nextID(path, id)
+			if err != nil {
+				return nil, err
+			}
+			if len(next) > 0 {
+				restore = append(restore, "=place-before="+next)
+			}
+		}
+		return []txUndo{{sentence: restore, oldID: id}}, nil
+	case "move":
+		next, err := tx.nextID(path, id)
+		if err != nil {
+			return nil, err
+		}
+		restore := []string{path + "/move", "=numbers=" + id}
+		if len(next) > 0 {
+			restore = append(restore, "=destination="+next)
+		}
+		return []txUndo{{sentence: restore}}, nil
+	}
+	return nil, fmt.Errorf("transaction cannot undo %s/%s", path, verb)
+}
+
+// nextID returns the ID of the item following id in an ordered menu, or "" if it is the last.
+func (tx *Tx) nextID(path, id string) (string, error) {
+	detail, err := tx.c.runArgs([]string{path + "/print", "=.proplist=.id"})
+	if err != nil {
+		return "", err
+	}
+	for i, re := range detail.Re {
+		if re.Map[".id"] == id && i+1 < len(detail.Re) {
+			return detail.Re[i+1].Map[".id"], nil
+		}
+	}
+	return "", nil
+}
+
+// argMap returns the =key=value words of a command as a map.
+func argMap(words []string) map[string]string {
+	m := make(map[string]string, len(words))
+	for _, word := range words {
+		if strings.HasPrefix(word, "=") {
+			kv := strings.SplitN(word[1:], "=", 2)
+			if len(kv) == 2 {
+				m[kv[0]] = kv[1]
+			} else {
+				m[kv[0]] = ""
+			}
+		}
+	}
+	return m
+}
+
+// remapIDs replaces an ID in an =.id=, =numbers=, =place-before= or =destination= word
+// with the ID it was given when the item was restored.
+func remapIDs(word string, newIDs map[string]string) string {
+	for _, key := range []string{"=.id=", "=numbers=", "=place-before=", "=destination="} {
+		if strings.HasPrefix(word, key) {
+			if id, found := newIDs[word[len(key):]]; found {
+				return key + id
+			}
+		}
+	}
+	return word
+}
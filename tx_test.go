@@ -0,0 +1,182 @@
+package gotik_test
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+// readWords reads a sentence as raw words, since the sentence reader of the fake
+// server does not accept query words such as ?.id=*1.
+func readWords(t *testing.T, r *bufio.Reader, want string) {
+	var words []string
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			t.Fatal(err)
+		}
+		n := int(b)
+		if b&0x80 != 0 {
+			b2, err := r.ReadByte()
+			if err != nil {
+				t.Fatal(err)
+			}
+			n = int(b&0x3f)<<8 | int(b2)
+		}
+		if n == 0 {
+			break
+		}
+		word := make([]byte, n)
+		if _, err = io.ReadFull(r, word); err != nil {
+			t.Fatal(err)
+		}
+		words = append(words, string(word))
+	}
+	if got := strings.Join(words, " "); got != want {
+		t.Fatalf("Sentence (%s); want (%s)", got, want)
+	}
+	t.Logf("< %s\n", want)
+}
+
+func TestTxRollback(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+	r := bufio.NewReader(s.Closer.(*conn).PipeReader)
+
+	go func() {
+		defer s.Close()
+		readWords(t, r, "/ip/address/add =address=10.0.0.1/24 =interface=ether1")
+		s.writeSentence(t, "!done", "=ret=*5")
+		readWords(t, r, "/ip/address/print ?.id=*2")
+		s.writeSentence(t, "!re", "=.id=*2", "=comment=old", "=disabled=false")
+		s.writeSentence(t, "!done")
+		readWords(t, r, "/ip/address/set =.id=*2 =comment=new")
+		s.writeSentence(t, "!done")
+		readWords(t, r, "/ip/address/print ?.id=*3")
+		s.writeSentence(t, "!re", "=.id=*3", "=address=10.0.1.1/24", "=interface=ether2", "=dynamic=false")
+		s.writeSentence(t, "!done")
+		readWords(t, r, "/ip/address/remove =.id=*3")
+		s.writeSentence(t, "!done")
+		readWords(t, r, "/queue/simple/print ?.id=*4")
+		s.writeSentence(t, "!re", "=.id=*4", "=name=guest", "=target=10.0.2.0/24", "=parent=none",
+			"=max-limit=1000000/5000000", "=rate=0/0", "=packet-rate=0/0", "=dropped=0/0", "=borrows=0/0",
+			"=lends=0/0", "=pcq-queues=0/0", "=total-rate=0", "=bytes=0/0", "=disabled=false", "=dynamic=false")
+		s.writeSentence(t, "!done")
+		readWords(t, r, "/queue/simple/print =.proplist=.id")
+		s.writeSentence(t, "!re", "=.id=*4")
+		s.writeSentence(t, "!re", "=.id=*7")
+		s.writeSentence(t, "!done")
+		readWords(t, r, "/queue/simple/remove =.id=*4")
+		s.writeSentence(t, "!done")
+
+		// rollback, most recent first
+		readWords(t, r, "/queue/simple/add =name=guest =target=10.0.2.0/24 =parent=none "+
+			"=max-limit=1000000/5000000 =disabled=false =place-before=*7")
+		s.writeSentence(t, "!done", "=ret=*8")
+		readWords(t, r, "/ip/address/add =address=10.0.1.1/24 =interface=ether2")
+		s.writeSentence(t, "!done", "=ret=*6")
+		readWords(t, r, "/ip/address/set =.id=*2 =comment=old")
+		s.writeSentence(t, "!done")
+		readWords(t, r, "/ip/address/remove =.id=*5")
+		s.writeSentence(t, "!done")
+	}()
+
+	errChanged := errors.New("changed my mind")
+	err := c.WithTx(func() error {
+		if _, err := c.Run("/ip/address/add", "=address=10.0.0.1/24", "=interface=ether1"); err != nil {
+			return err
+		}
+		if _, err := c.Run("/ip/address/set", "=.id=*2", "=comment=new"); err != nil {
+			return err
+		}
+		if _, err := c.Run("/ip/address/remove", "=.id=*3"); err != nil {
+			return err
+		}
+		if _, err := c.Run("/queue/simple/remove", "=.id=*4"); err != nil {
+			return err
+		}
+		return errChanged
+	})
+	if !errors.Is(err, errChanged) {
+		t.Fatalf("WithTx()=%v; want %v", err, errChanged)
+	}
+}
+
+func TestTxCommit(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+
+	go func() {
+		defer s.Close()
+		s.readSentence(t, "/ip/address/add @ [{`address` `10.0.0.1/24`}]")
+		s.writeSentence(t, "!done", "=ret=*5")
+	}()
+
+	tx, err := c.BeginTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.BeginTx(); err == nil {
+		t.Fatal("BeginTx() succeeded with a transaction active; want error")
+	}
+	if _, err = tx.Run("/ip/address/add", "=address=10.0.0.1/24"); err != nil {
+		t.Fatal(err)
+	}
+	if err = tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	if err = tx.Rollback(); err == nil {
+		t.Fatal("Rollback() succeeded after Commit(); want error")
+	}
+}
+
+func TestTxRemoveUnknownMenu(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+	defer s.Close()
+
+	tx, err := c.BeginTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback()
+	// nothing may be sent: the fake server is not reading
+	if _, err = c.Run("/interface/bridge/remove", "=.id=*1"); err == nil {
+		t.Fatal("remove on a menu with unknown properties succeeded; want error")
+	}
+}
+
+func TestTxRollbackUnset(t *testing.T) {
+	c, s := newPair(t)
+	defer c.Close()
+	r := bufio.NewReader(s.Closer.(*conn).PipeReader)
+
+	go func() {
+		defer s.Close()
+		// parent was never set, so the print leaves it out
+		readWords(t, r, "/queue/simple/print ?.id=*4")
+		s.writeSentence(t, "!re", "=.id=*4", "=name=guest", "=target=10.0.2.0/24", "=comment=old")
+		s.writeSentence(t, "!done")
+		readWords(t, r, "/queue/simple/set =.id=*4 =comment=new =parent=total")
+		s.writeSentence(t, "!done")
+
+		// rollback
+		readWords(t, r, "/queue/simple/set =.id=*4 =comment=old")
+		s.writeSentence(t, "!done")
+		readWords(t, r, "/queue/simple/unset =.id=*4 =value-name=parent")
+		s.writeSentence(t, "!done")
+	}()
+
+	tx, err := c.BeginTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.Run("/queue/simple/set", "=.id=*4", "=comment=new", "=parent=total"); err != nil {
+		t.Fatal(err)
+	}
+	if err = tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -264,25 +264,27 @@ type IPv4NatRule struct {
 	RouterLocation      string `tik:"/ip/firewall/nat"`
 	ID                  string `tik:".id"`
 	PlaceBeforePosition string
-	PlaceBefore         string `tik:"place-before"`
-	Disabled            bool   `tik:"disabled"`
-	Dynamic             bool   `tik:"dynamic"`
-	Invalid             bool   `tik:"invalid"`
-	Chain               string `tik:"chain"`
-	SrcAddress          string `tik:"src-address"`
-	DstAddress          string `tik:"dst-address"`
-	Protocol            string `tik:"protocol"`
-	SrcPort             int    `tik:"src-port"`
-	DstPort             int    `tik:"dst-port"`
-	InInterface         string `tik:"in-interface"`
-	OutInterface        string `tik:"out-interface"`
-	SrcAddressList      string `tik:"src-address-list"`
-	DstAddressList      string `tik:"dst-address-list"`
-	ToAddresses         string `tik:"to-addresses"`
-	ToPorts             int    `tik:"to-ports"`
-	Comment             string `tik:"comment"`
-	Action              string `tik:"action"`
-	JumpTarget          string `tik:"jump-target"`
+	PlaceBefore         string  `tik:"place-before"`
+	Disabled            bool    `tik:"disabled"`
+	Dynamic             bool    `tik:"dynamic"`
+	Invalid             bool    `tik:"invalid"`
+	Chain               string  `tik:"chain"`
+	SrcAddress          string  `tik:"src-address"`
+	DstAddress          string  `tik:"dst-address"`
+	Protocol            string  `tik:"protocol"`
+	SrcPort             PortSet `tik:"src-port"`
+	DstPort             PortSet `tik:"dst-port"`
+	InInterface         string  `tik:"in-interface"`
+	OutInterface        string  `tik:"out-interface"`
+	SrcAddressList      string  `tik:"src-address-list"`
+	DstAddressList      string  `tik:"dst-address-list"`
+	ToAddresses         string  `tik:"to-addresses"`
+	ToPorts             PortSet `tik:"to-ports"`
+	Comment             string  `tik:"comment"`
+	Action              string  `tik:"action"`
+	JumpTarget          string  `tik:"jump-target"`
+	Bytes               int64   // Bytes matched by the rule (read-only counter)
+	Packets             int64   // Packets matched by the rule (read-only counter)
 }
 
 type IPv4FilterRule struct {
@@ -299,7 +301,7 @@ type IPv4FilterRule struct {
 	Invalid                 bool          `tik:"invalid"`
 	DstAddress              string        `tik:"dst-address"`
 	DstAddressList          string        `tik:"dst-address-list"`
-	DstPort                 string        `tik:"dst-port"`
+	DstPort                 PortSet       `tik:"dst-port"`
 	InInterface             string        `tik:"in-interface"`
 	InInterfaceList         string        `tik:"in-interface-list"`
 	JumpTarget              string        `tik:"jump-target"`
@@ -312,7 +314,7 @@ type IPv4FilterRule struct {
 	RejectWith              string        `tik:"reject-with"`
 	SrcAddress              string        `tik:"src-address"`
 	SrcAddressList          string        `tik:"src-address-list"`
-	SrcPort                 string        `tik:"src-port"`
+	SrcPort                 PortSet       `tik:"src-port"`
 	TcpFlags                string        `tik:"tcp-flags"`
 	TcpMss                  string        `tik:"tcp-mss"`
 	ConnectionBytes         string        `tik:"connection-bytes"`          // Match packets with given bytes or byte range
@@ -342,7 +344,7 @@ type IPv4FilterRule struct {
 	PacketMark              string        `tik:"packet-mark"`               // Matches packets marked via mangle facility with particular packet mark
 	PacketSize              string        `tik:"packet-size"`               // Packet size or range in bytes
 	PerConnectionClassifier string        `tik:"per-connection-classifier"` //
-	Port                    string        `tik:"port"`                      //
+	Port                    PortSet       `tik:"port"`                      //
 	Priority                string        `tik:"priority"`                  //
 	PSD                     string        `tik:"psd"`                       // Detect TCP un UDP scans
 	PktRandom               string        `tik:"random"`                    // Match packets randomly with given propability
@@ -353,6 +355,393 @@ type IPv4FilterRule struct {
 	PktTime                 string        `tik:"time"`                      // Packet arrival time and date or locally generated packets departure time and date
 	TLSHost                 string        `tik:"tls-host"`                  //
 	TTL                     string        `tik:"ttl"`                       //
+	Bytes                   int64         // Bytes matched by the rule (read-only counter)
+	Packets                 int64         // Packets matched by the rule (read-only counter)
+}
+
+// IPv4MangleRule is a rule of /ip/firewall/mangle.  Besides the matchers of IPv4FilterRule, it
+// has the properties of the marking and header changing actions.
+type IPv4MangleRule struct {
+	RouterLocation          string `tik:"/ip/firewall/mangle"`
+	ID                      string `tik:".id"`
+	PlaceBeforePosition     string
+	Action                  string        `tik:"action"`
+	AddressList             string        `tik:"address-list"`
+	AddressListTimeout      time.Duration `tik:"address-list-timeout"`
+	NewConnectionMark       string        `tik:"new-connection-mark"` // for action=mark-connection
+	NewPacketMark           string        `tik:"new-packet-mark"`     // for action=mark-packet
+	NewRoutingMark          string        `tik:"new-routing-mark"`    // for action=mark-routing
+	Passthrough             string        `tik:"passthrough"`         // "yes" or "no"; RouterOS defaults to yes for the mark actions
+	NewMss                  string        `tik:"new-mss"`             // for action=change-mss, a size or "clamp-to-pmtu"
+	NewDSCP                 string        `tik:"new-dscp"`            // for action=change-dscp, 0..63
+	NewPriority             string        `tik:"new-priority"`        // for action=set-priority
+	NewTTL                  string        `tik:"new-ttl"`             // for action=change-ttl, for example "decrement:1"
+	RouteDst                string        `tik:"route-dst"`           // for action=route
+	Chain                   string        `tik:"chain"`
+	Comment                 string        `tik:"comment"`
+	Disabled                bool          `tik:"disabled"`
+	Dynamic                 bool          `tik:"dynamic"`
+	Invalid                 bool          `tik:"invalid"`
+	DstAddress              string        `tik:"dst-address"`
+	DstAddressList          string        `tik:"dst-address-list"`
+	DstPort                 PortSet       `tik:"dst-port"`
+	InInterface             string        `tik:"in-interface"`
+	InInterfaceList         string        `tik:"in-interface-list"`
+	JumpTarget              string        `tik:"jump-target"`
+	Log                     bool          `tik:"log"`
+	LogPrefix               string        `tik:"log-prefix"`
+	OutInterface            string        `tik:"out-interface"`
+	OutInterfaceList        string        `tik:"out-interface-list"`
+	PlaceBefore             string        `tik:"place-before"`
+	Protocol                string        `tik:"protocol"`
+	SrcAddress              string        `tik:"src-address"`
+	SrcAddressList          string        `tik:"src-address-list"`
+	SrcPort                 PortSet       `tik:"src-port"`
+	TcpFlags                string        `tik:"tcp-flags"`
+	TcpMss                  string        `tik:"tcp-mss"`
+	ConnectionBytes         string        `tik:"connection-bytes"`          // Match packets with given bytes or byte range
+	ConnectionLimit         string        `tik:"connection-limit"`          // Restrict connection limit per address or address block
+	ConnectionMark          string        `tik:"connection-mark"`           // Matches packets marked via mangle facility with particular connection mark
+	ConnectionNatState      string        `tik:"connection-nat-state"`      // dstnat, srcnat, !dstnat, !srcnat
+	ConnectionRate          string        `tik:"connection-rate"`           // ConnectionRate ::= [!]From,To ::= 0..4294967295
+	ConnectionState         string        `tik:"connection-state"`          // Interprets the connection tracking analysis data for a particular packet
+	ConnectionType          string        `tik:"connection-type"`           // Match packets with given connection type
+	Content                 string        `tik:"content"`                   // The text packets should contain in order to match the rule
+	DSCP                    string        `tik:"dscp"`                      //
+	DstAddressType          string        `tik:"dst-address-type"`          // Destination address type
+	DstLimit                string        `tik:"dst-limit"`                 // Packet limitation per time with burst to dst-address, dst-port or src-address
+	Fragment                string        `tik:"fragment"`                  //
+	Hotspot                 string        `tik:"hotspot"`                   // Matches packets received from clients against various Hot-Spot
+	IcmpOptions             string        `tik:"icmp-options"`              // IcmpOptions ::= [!]Type[:Code]; Type ::= 0..255; Code ::= Start[-End] ::= 0..255
+	InBridgePort            string        `tik:"in-bridge-port"`            //
+	InBridgePortList        string        `tik:"in-bridge-port-list"`       //
+	IngressPriority         string        `tik:"ingress-priority"`          // IngressPriority ::= [!]IngressPriority ::= 0..63
+	IpsecPolicy             string        `tik:"ipsec-policy"`              //
+	Ipv4Options             string        `tik:"ipv4-options"`              // Match ipv4 header options
+	Layer7Protocol          string        `tik:"layer7-protocol"`           //
+	Limit                   string        `tik:"limit"`                     // Setup burst, how many times to use it in during time interval measured in seconds
+	Nth                     string        `tik:"nth"`                       // Match nth packets received by the rule
+	OutBridgePort           string        `tik:"out-bridge-port"`           // Matches the bridge port physical output device added to a bridge device
+	OutBridgePortList       string        `tik:"out-bridge-port-list"`      //
+	PacketMark              string        `tik:"packet-mark"`               // Matches packets marked via mangle facility with particular packet mark
+	PacketSize              string        `tik:"packet-size"`               // Packet size or range in bytes
+	PerConnectionClassifier string        `tik:"per-connection-classifier"` //
+	Port                    PortSet       `tik:"port"`                      //
+	Priority                string        `tik:"priority"`                  //
+	PSD                     string        `tik:"psd"`                       // Detect TCP un UDP scans
+	PktRandom               string        `tik:"random"`                    // Match packets randomly with given propability
+	RoutingMark             string        `tik:"routing-mark"`              // Matches packets marked by mangle facility with particular routing mark
+	RoutingTable            string        `tik:"routing-table"`             //
+	SrcAddressType          string        `tik:"src-address-type"`          // Source IP address type
+	SrcMacAddress           string        `tik:"src-mac-address"`           // Source MAC address
+	PktTime                 string        `tik:"time"`                      // Packet arrival time and date or locally generated packets departure time and date
+	TLSHost                 string        `tik:"tls-host"`                  //
+	TTL                     string        `tik:"ttl"`                       //
+	Bytes                   int64         // Bytes matched by the rule (read-only counter)
+	Packets                 int64         // Packets matched by the rule (read-only counter)
+}
+
+// IPv4RawRule is a rule of /ip/firewall/raw, which is processed before connection tracking and
+// so has no connection matchers.  Its actions include notrack.
+type IPv4RawRule struct {
+	RouterLocation          string `tik:"/ip/firewall/raw"`
+	ID                      string `tik:".id"`
+	PlaceBeforePosition     string
+	Action                  string        `tik:"action"`
+	AddressList             string        `tik:"address-list"`
+	AddressListTimeout      time.Duration `tik:"address-list-timeout"`
+	Chain                   string        `tik:"chain"`
+	Comment                 string        `tik:"comment"`
+	Disabled                bool          `tik:"disabled"`
+	Dynamic                 bool          `tik:"dynamic"`
+	Invalid                 bool          `tik:"invalid"`
+	DstAddress              string        `tik:"dst-address"`
+	DstAddressList          string        `tik:"dst-address-list"`
+	DstPort                 PortSet       `tik:"dst-port"`
+	InInterface             string        `tik:"in-interface"`
+	InInterfaceList         string        `tik:"in-interface-list"`
+	JumpTarget              string        `tik:"jump-target"`
+	Log                     bool          `tik:"log"`
+	LogPrefix               string        `tik:"log-prefix"`
+	OutInterface            string        `tik:"out-interface"`
+	OutInterfaceList        string        `tik:"out-interface-list"`
+	PlaceBefore             string        `tik:"place-before"`
+	Protocol                string        `tik:"protocol"`
+	SrcAddress              string        `tik:"src-address"`
+	SrcAddressList          string        `tik:"src-address-list"`
+	SrcPort                 PortSet       `tik:"src-port"`
+	TcpFlags                string        `tik:"tcp-flags"`
+	TcpMss                  string        `tik:"tcp-mss"`
+	Content                 string        `tik:"content"`                   // The text packets should contain in order to match the rule
+	DSCP                    string        `tik:"dscp"`                      //
+	DstAddressType          string        `tik:"dst-address-type"`          // Destination address type
+	DstLimit                string        `tik:"dst-limit"`                 // Packet limitation per time with burst to dst-address, dst-port or src-address
+	Fragment                string        `tik:"fragment"`                  //
+	IcmpOptions             string        `tik:"icmp-options"`              // IcmpOptions ::= [!]Type[:Code]; Type ::= 0..255; Code ::= Start[-End] ::= 0..255
+	InBridgePort            string        `tik:"in-bridge-port"`            //
+	InBridgePortList        string        `tik:"in-bridge-port-list"`       //
+	IngressPriority         string        `tik:"ingress-priority"`          // IngressPriority ::= [!]IngressPriority ::= 0..63
+	IpsecPolicy             string        `tik:"ipsec-policy"`              //
+	Ipv4Options             string        `tik:"ipv4-options"`              // Match ipv4 header options
+	Limit                   string        `tik:"limit"`                     // Setup burst, how many times to use it in during time interval measured in seconds
+	Nth                     string        `tik:"nth"`                       // Match nth packets received by the rule
+	OutBridgePort           string        `tik:"out-bridge-port"`           // Matches the bridge port physical output device added to a bridge device
+	OutBridgePortList       string        `tik:"out-bridge-port-list"`      //
+	PacketSize              string        `tik:"packet-size"`               // Packet size or range in bytes
+	PerConnectionClassifier string        `tik:"per-connection-classifier"` //
+	Port                    PortSet       `tik:"port"`                      //
+	Priority                string        `tik:"priority"`                  //
+	PSD                     string        `tik:"psd"`                       // Detect TCP un UDP scans
+	PktRandom               string        `tik:"random"`                    // Match packets randomly with given propability
+	SrcAddressType          string        `tik:"src-address-type"`          // Source IP address type
+	SrcMacAddress           string        `tik:"src-mac-address"`           // Source MAC address
+	PktTime                 string        `tik:"time"`                      // Packet arrival time and date or locally generated packets departure time and date
+	TLSHost                 string        `tik:"tls-host"`                  //
+	TTL                     string        `tik:"ttl"`                       //
+	Bytes                   int64         // Bytes matched by the rule (read-only counter)
+	Packets                 int64         // Packets matched by the rule (read-only counter)
+}
+
+// IPv6FilterRule is a rule of /ipv6/firewall/filter.  It has the matchers of IPv4FilterRule other
+// than those which only apply to IPv4, plus the IPv6 extension header and hop limit matchers.
+type IPv6FilterRule struct {
+	RouterLocation          string `tik:"/ipv6/firewall/filter"`
+	ID                      string `tik:".id"`
+	PlaceBeforePosition     string
+	Action                  string        `tik:"action"`
+	AddressList             string        `tik:"address-list"`
+	AddressListTimeout      time.Duration `tik:"address-list-timeout"`
+	Chain                   string        `tik:"chain"`
+	Comment                 string        `tik:"comment"`
+	Disabled                bool          `tik:"disabled"`
+	Dynamic                 bool          `tik:"dynamic"`
+	Invalid                 bool          `tik:"invalid"`
+	DstAddress              string        `tik:"dst-address"`
+	DstAddressList          string        `tik:"dst-address-list"`
+	DstPort                 PortSet       `tik:"dst-port"`
+	InInterface             string        `tik:"in-interface"`
+	InInterfaceList         string        `tik:"in-interface-list"`
+	JumpTarget              string        `tik:"jump-target"`
+	Log                     bool          `tik:"log"`
+	LogPrefix               string        `tik:"log-prefix"`
+	OutInterface            string        `tik:"out-interface"`
+	OutInterfaceList        string        `tik:"out-interface-list"`
+	PlaceBefore             string        `tik:"place-before"`
+	Protocol                string        `tik:"protocol"`
+	RejectWith              string        `tik:"reject-with"`
+	SrcAddress              string        `tik:"src-address"`
+	SrcAddressList          string        `tik:"src-address-list"`
+	SrcPort                 PortSet       `tik:"src-port"`
+	TcpFlags                string        `tik:"tcp-flags"`
+	TcpMss                  string        `tik:"tcp-mss"`
+	ConnectionBytes         string        `tik:"connection-bytes"`          // Match packets with given bytes or byte range
+	ConnectionLimit         string        `tik:"connection-limit"`          // Restrict connection limit per address or address block
+	ConnectionMark          string        `tik:"connection-mark"`           // Matches packets marked via mangle facility with particular connection mark
+	ConnectionNatState      string        `tik:"connection-nat-state"`      // dstnat, srcnat, !dstnat, !srcnat
+	ConnectionRate          string        `tik:"connection-rate"`           // ConnectionRate ::= [!]From,To ::= 0..4294967295
+	ConnectionState         string        `tik:"connection-state"`          // Interprets the connection tracking analysis data for a particular packet
+	ConnectionType          string        `tik:"connection-type"`           // Match packets with given connection type
+	Content                 string        `tik:"content"`                   // The text packets should contain in order to match the rule
+	DSCP                    string        `tik:"dscp"`                      //
+	DstAddressType          string        `tik:"dst-address-type"`          // Destination address type
+	DstLimit                string        `tik:"dst-limit"`                 // Packet limitation per time with burst to dst-address, dst-port or src-address
+	IcmpOptions             string        `tik:"icmp-options"`              // IcmpOptions ::= [!]Type[:Code]; Type ::= 0..255; Code ::= Start[-End] ::= 0..255
+	InBridgePort            string        `tik:"in-bridge-port"`            //
+	InBridgePortList        string        `tik:"in-bridge-port-list"`       //
+	IngressPriority         string        `tik:"ingress-priority"`          // IngressPriority ::= [!]IngressPriority ::= 0..63
+	IpsecPolicy             string        `tik:"ipsec-policy"`              //
+	Limit                   string        `tik:"limit"`                     // Setup burst, how many times to use it in during time interval measured in seconds
+	Nth                     string        `tik:"nth"`                       // Match nth packets received by the rule
+	OutBridgePort           string        `tik:"out-bridge-port"`           // Matches the bridge port physical output device added to a bridge device
+	OutBridgePortList       string        `tik:"out-bridge-port-list"`      //
+	PacketMark              string        `tik:"packet-mark"`               // Matches packets marked via mangle facility with particular packet mark
+	PacketSize              string        `tik:"packet-size"`               // Packet size or range in bytes
+	PerConnectionClassifier string        `tik:"per-connection-classifier"` //
+	Port                    PortSet       `tik:"port"`                      //
+	Priority                string        `tik:"priority"`                  //
+	PSD                     string        `tik:"psd"`                       // Detect TCP un UDP scans
+	PktRandom               string        `tik:"random"`                    // Match packets randomly with given propability
+	RoutingMark             string        `tik:"routing-mark"`              // Matches packets marked by mangle facility with particular routing mark
+	RoutingTable            string        `tik:"routing-table"`             //
+	SrcAddressType          string        `tik:"src-address-type"`          // Source IP address type
+	SrcMacAddress           string        `tik:"src-mac-address"`           // Source MAC address
+	PktTime                 string        `tik:"time"`                      // Packet arrival time and date or locally generated packets departure time and date
+	TLSHost                 string        `tik:"tls-host"`                  //
+	Headers                 string        `tik:"headers"`                   // Match IPv6 extension headers, for example "hop,frag"
+	HopLimit                string        `tik:"hop-limit"`                 // HopLimit ::= [!]equal|greater-than|less-than|not-equal:Value
+	Bytes                   int64         // Bytes matched by the rule (read-only counter)
+	Packets                 int64         // Packets matched by the rule (read-only counter)
+}
+
+// IPv6NatRule is a rule of /ipv6/firewall/nat, available in RouterOS 7.
+type IPv6NatRule struct {
+	RouterLocation      string `tik:"/ipv6/firewall/nat"`
+	ID                  string `tik:".id"`
+	PlaceBeforePosition string
+	PlaceBefore         string  `tik:"place-before"`
+	Disabled            bool    `tik:"disabled"`
+	Dynamic             bool    `tik:"dynamic"`
+	Invalid             bool    `tik:"invalid"`
+	Chain               string  `tik:"chain"`
+	SrcAddress          string  `tik:"src-address"`
+	DstAddress          string  `tik:"dst-address"`
+	Protocol            string  `tik:"protocol"`
+	SrcPort             PortSet `tik:"src-port"`
+	DstPort             PortSet `tik:"dst-port"`
+	InInterface         string  `tik:"in-interface"`
+	InInterfaceList     string  `tik:"in-interface-list"`
+	OutInterface        string  `tik:"out-interface"`
+	OutInterfaceList    string  `tik:"out-interface-list"`
+	SrcAddressList      string  `tik:"src-address-list"`
+	DstAddressList      string  `tik:"dst-address-list"`
+	ConnectionMark      string  `tik:"connection-mark"`
+	PacketMark          string  `tik:"packet-mark"`
+	ToAddress           string  `tik:"to-address"`
+	ToPorts             PortSet `tik:"to-ports"`
+	Comment             string  `tik:"comment"`
+	Action              string  `tik:"action"`
+	JumpTarget          string  `tik:"jump-target"`
+	Log                 bool    `tik:"log"`
+	LogPrefix           string  `tik:"log-prefix"`
+	Bytes               int64   // Bytes matched by the rule (read-only counter)
+	Packets             int64   // Packets matched by the rule (read-only counter)
+}
+
+// IPv6MangleRule is a rule of /ipv6/firewall/mangle.
+type IPv6MangleRule struct {
+	RouterLocation          string `tik:"/ipv6/firewall/mangle"`
+	ID                      string `tik:".id"`
+	PlaceBeforePosition     string
+	Action                  string        `tik:"action"`
+	AddressList             string        `tik:"address-list"`
+	AddressListTimeout      time.Duration `tik:"address-list-timeout"`
+	NewConnectionMark       string        `tik:"new-connection-mark"` // for action=mark-connection
+	NewPacketMark           string        `tik:"new-packet-mark"`     // for action=mark-packet
+	NewRoutingMark          string        `tik:"new-routing-mark"`    // for action=mark-routing
+	Passthrough             string        `tik:"passthrough"`         // "yes" or "no"; RouterOS defaults to yes for the mark actions
+	NewMss                  string        `tik:"new-mss"`             // for action=change-mss, a size or "clamp-to-pmtu"
+	NewDSCP                 string        `tik:"new-dscp"`            // for action=change-dscp, 0..63
+	NewPriority             string        `tik:"new-priority"`        // for action=set-priority
+	NewHopLimit             string        `tik:"new-hop-limit"`       // for action=change-hop-limit, for example "decrement:1"
+	RouteDst                string        `tik:"route-dst"`           // for action=route
+	Chain                   string        `tik:"chain"`
+	Comment                 string        `tik:"comment"`
+	Disabled                bool          `tik:"disabled"`
+	Dynamic                 bool          `tik:"dynamic"`
+	Invalid                 bool          `tik:"invalid"`
+	DstAddress              string        `tik:"dst-address"`
+	DstAddressList          string        `tik:"dst-address-list"`
+	DstPort                 PortSet       `tik:"dst-port"`
+	InInterface             string        `tik:"in-interface"`
+	InInterfaceList         string        `tik:"in-interface-list"`
+	JumpTarget              string        `tik:"jump-target"`
+	Log                     bool          `tik:"log"`
+	LogPrefix               string        `tik:"log-prefix"`
+	OutInterface            string        `tik:"out-interface"`
+	OutInterfaceList        string        `tik:"out-interface-list"`
+	PlaceBefore             string        `tik:"place-before"`
+	Protocol                string        `tik:"protocol"`
+	SrcAddress              string        `tik:"src-address"`
+	SrcAddressList          string        `tik:"src-address-list"`
+	SrcPort                 PortSet       `tik:"src-port"`
+	TcpFlags                string        `tik:"tcp-flags"`
+	TcpMss                  string        `tik:"tcp-mss"`
+	ConnectionBytes         string        `tik:"connection-bytes"`          // Match packets with given bytes or byte range
+	ConnectionLimit         string        `tik:"connection-limit"`          // Restrict connection limit per address or address block
+	ConnectionMark          string        `tik:"connection-mark"`           // Matches packets marked via mangle facility with particular connection mark
+	ConnectionNatState      string        `tik:"connection-nat-state"`      // dstnat, srcnat, !dstnat, !srcnat
+	ConnectionRate          string        `tik:"connection-rate"`           // ConnectionRate ::= [!]From,To ::= 0..4294967295
+	ConnectionState         string        `tik:"connection-state"`          // Interprets the connection tracking analysis data for a particular packet
+	ConnectionType          string        `tik:"connection-type"`           // Match packets with given connection type
+	Content                 string        `tik:"content"`                   // The text packets should contain in order to match the rule
+	DSCP                    string        `tik:"dscp"`                      //
+	DstAddressType          string        `tik:"dst-address-type"`          // Destination address type
+	DstLimit                string        `tik:"dst-limit"`                 // Packet limitation per time with burst to dst-address, dst-port or src-address
+	IcmpOptions             string        `tik:"icmp-options"`              // IcmpOptions ::= [!]Type[:Code]; Type ::= 0..255; Code ::= Start[-End] ::= 0..255
+	InBridgePort            string        `tik:"in-bridge-port"`            //
+	InBridgePortList        string        `tik:"in-bridge-port-list"`       //
+	IngressPriority         string        `tik:"ingress-priority"`          // IngressPriority ::= [!]IngressPriority ::= 0..63
+	IpsecPolicy             string        `tik:"ipsec-policy"`              //
+	Limit                   string        `tik:"limit"`                     // Setup burst, how many times to use it in during time interval measured in seconds
+	Nth                     string        `tik:"nth"`                       // Match nth packets received by the rule
+	OutBridgePort           string        `tik:"out-bridge-port"`           // Matches the bridge port physical output device added to a bridge device
+	OutBridgePortList       string        `tik:"out-bridge-port-list"`      //
+	PacketMark              string        `tik:"packet-mark"`               // Matches packets marked via mangle facility with particular packet mark
+	PacketSize              string        `tik:"packet-size"`               // Packet size or range in bytes
+	PerConnectionClassifier string        `tik:"per-connection-classifier"` //
+	Port                    PortSet       `tik:"port"`                      //
+	Priority                string        `tik:"priority"`                  //
+	PSD                     string        `tik:"psd"`                       // Detect TCP un UDP scans
+	PktRandom               string        `tik:"random"`                    // Match packets randomly with given propability
+	RoutingMark             string        `tik:"routing-mark"`              // Matches packets marked by mangle facility with particular routing mark
+	RoutingTable            string        `tik:"routing-table"`             //
+	SrcAddressType          string        `tik:"src-address-type"`          // Source IP address type
+	SrcMacAddress           string        `tik:"src-mac-address"`           // Source MAC address
+	PktTime                 string        `tik:"time"`                      // Packet arrival time and date or locally generated packets departure time and date
+	TLSHost                 string        `tik:"tls-host"`                  //
+	Headers                 string        `tik:"headers"`                   // Match IPv6 extension headers, for example "hop,frag"
+	HopLimit                string        `tik:"hop-limit"`                 // HopLimit ::= [!]equal|greater-than|less-than|not-equal:Value
+	Bytes                   int64         // Bytes matched by the rule (read-only counter)
+	Packets                 int64         // Packets matched by the rule (read-only counter)
+}
+
+// IPv6RawRule is a rule of /ipv6/firewall/raw.
+type IPv6RawRule struct {
+	RouterLocation          string `tik:"/ipv6/firewall/raw"`
+	ID                      string `tik:".id"`
+	PlaceBeforePosition     string
+	Action                  string        `tik:"action"`
+	AddressList             string        `tik:"address-list"`
+	AddressListTimeout      time.Duration `tik:"address-list-timeout"`
+	Chain                   string        `tik:"chain"`
+	Comment                 string        `tik:"comment"`
+	Disabled                bool          `tik:"disabled"`
+	Dynamic                 bool          `tik:"dynamic"`
+	Invalid                 bool          `tik:"invalid"`
+	DstAddress              string        `tik:"dst-address"`
+	DstAddressList          string        `tik:"dst-address-list"`
+	DstPort                 PortSet       `tik:"dst-port"`
+	InInterface             string        `tik:"in-interface"`
+	InInterfaceList         string        `tik:"in-interface-list"`
+	JumpTarget              string        `tik:"jump-target"`
+	Log                     bool          `tik:"log"`
+	LogPrefix               string        `tik:"log-prefix"`
+	OutInterface            string        `tik:"out-interface"`
+	OutInterfaceList        string        `tik:"out-interface-list"`
+	PlaceBefore             string        `tik:"place-before"`
+	Protocol                string        `tik:"protocol"`
+	SrcAddress              string        `tik:"src-address"`
+	SrcAddressList          string        `tik:"src-address-list"`
+	SrcPort                 PortSet       `tik:"src-port"`
+	TcpFlags                string        `tik:"tcp-flags"`
+	TcpMss                  string        `tik:"tcp-mss"`
+	Content                 string        `tik:"content"`                   // The text packets should contain in order to match the rule
+	DSCP                    string        `tik:"dscp"`                      //
+	DstAddressType          string        `tik:"dst-address-type"`          // Destination address type
+	DstLimit                string        `tik:"dst-limit"`                 // Packet limitation per time with burst to dst-address, dst-port or src-address
+	IcmpOptions             string        `tik:"icmp-options"`              // IcmpOptions ::= [!]Type[:Code]; Type ::= 0..255; Code ::= Start[-End] ::= 0..255
+	InBridgePort            string        `tik:"in-bridge-port"`            //
+	InBridgePortList        string        `tik:"in-bridge-port-list"`       //
+	IngressPriority         string        `tik:"ingress-priority"`          // IngressPriority ::= [!]IngressPriority ::= 0..63
+	IpsecPolicy             string        `tik:"ipsec-policy"`              //
+	Limit                   string        `tik:"limit"`                     // Setup burst, how many times to use it in during time interval measured in seconds
+	Nth                     string        `tik:"nth"`                       // Match nth packets received by the rule
+	OutBridgePort           string        `tik:"out-bridge-port"`           // Matches the bridge port physical output device added to a bridge device
+	OutBridgePortList       string        `tik:"out-bridge-port-list"`      //
+	PacketSize              string        `tik:"packet-size"`               // Packet size or range in bytes
+	PerConnectionClassifier string        `tik:"per-connection-classifier"` //
+	Port                    PortSet       `tik:"port"`                      //
+	Priority                string        `tik:"priority"`                  //
+	PSD                     string        `tik:"psd"`                       // Detect TCP un UDP scans
+	PktRandom               string        `tik:"random"`                    // Match packets randomly with given propability
+	SrcAddressType          string        `tik:"src-address-type"`          // Source IP address type
+	SrcMacAddress           string        `tik:"src-mac-address"`           // Source MAC address
+	PktTime                 string        `tik:"time"`                      // Packet arrival time and date or locally generated packets departure time and date
+	TLSHost                 string        `tik:"tls-host"`                  //
+	Headers                 string        `tik:"headers"`                   // Match IPv6 extension headers, for example "hop,frag"
+	HopLimit                string        `tik:"hop-limit"`                 // HopLimit ::= [!]equal|greater-than|less-than|not-equal:Value
+	Bytes                   int64         // Bytes matched by the rule (read-only counter)
+	Packets                 int64         // Packets matched by the rule (read-only counter)
 }
 
 type PackageUpdate struct {